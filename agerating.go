@@ -11,15 +11,29 @@ import (
 // AgeRating describes an age rating according to various organizations.
 // For more information visit: https://api-docs.igdb.com/#age-rating
 type AgeRating struct {
+	Presence
+	Extras
 	ID                  int               `json:"id"`
 	Category            AgeRatingCategory `json:"category"`
 	ContentDescriptions []int             `json:"content_descriptions"`
+	Organization        int               `json:"organization"`
 	Rating              AgeRatingEnum     `json:"rating"`
+	RatingCategory      int               `json:"rating_category"`
 	RatingCoverURL      string            `json:"rating_cover_url"`
 	Synopsis            string            `json:"synopsis"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the AgeRating and
+// records which of its fields were present or unrecognized.
+func (a *AgeRating) UnmarshalJSON(b []byte) error {
+	type ageRating AgeRating
+	return decodeModel(b, (*ageRating)(a), &a.Presence, &a.Extras)
+}
+
 // AgeRatingCategory specifies a regulatory organization.
+//
+// The IGDB v4 deprecates the category field of an AgeRating in favor of
+// the organization field, which holds an Age Rating Organization ID instead.
 type AgeRatingCategory int
 
 //go:generate stringer -type=AgeRatingCategory,AgeRatingEnum
@@ -31,6 +45,9 @@ const (
 )
 
 // AgeRatingEnum specifies a specific age rating.
+//
+// The IGDB v4 deprecates the rating field of an AgeRating in favor of the
+// rating_category field, which holds an Age Rating Category ID instead.
 type AgeRatingEnum int
 
 // Expected AgeRatingEnum enums from the IGDB.
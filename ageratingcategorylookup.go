@@ -0,0 +1,115 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct AgeRatingCategoryLookup -add-tags json -w
+
+// AgeRatingCategoryLookup represents a rating given by a particular age
+// rating organization. It replaces the AgeRatingEnum enums in the IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#age-rating-category
+type AgeRatingCategoryLookup struct {
+	Presence
+	Extras
+	ID           int       `json:"id"`
+	Checksum     string    `json:"checksum"`
+	CreatedAt    Timestamp `json:"created_at"`
+	Organization int       `json:"organization"`
+	Rating       string    `json:"rating"`
+	UpdatedAt    Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the AgeRatingCategoryLookup and
+// records which of its fields were present or unrecognized.
+func (a *AgeRatingCategoryLookup) UnmarshalJSON(b []byte) error {
+	type ageRatingCategoryLookup AgeRatingCategoryLookup
+	return decodeModel(b, (*ageRatingCategoryLookup)(a), &a.Presence, &a.Extras)
+}
+
+// AgeRatingCategoryLookupService handles all the API calls for the IGDB AgeRatingCategoryLookup endpoint.
+type AgeRatingCategoryLookupService service
+
+// Get returns a single AgeRatingCategoryLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any AgeRatingCategoryLookups, an error is returned.
+func (as *AgeRatingCategoryLookupService) Get(id int, opts ...Option) (*AgeRatingCategoryLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var cat []*AgeRatingCategoryLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := as.client.post(as.end, &cat, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get AgeRatingCategoryLookup with ID %v", id)
+	}
+
+	return cat[0], nil
+}
+
+// List returns a list of AgeRatingCategoryLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a AgeRatingCategoryLookup is ignored. If none of the IDs
+// match a AgeRatingCategoryLookup, an error is returned.
+func (as *AgeRatingCategoryLookupService) List(ids []int, opts ...Option) ([]*AgeRatingCategoryLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var cat []*AgeRatingCategoryLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := as.client.post(as.end, &cat, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get AgeRatingCategoryLookups with IDs %v", ids)
+	}
+
+	return cat, nil
+}
+
+// Index returns an index of AgeRatingCategoryLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no AgeRatingCategoryLookups can
+// be found using the provided options, an error is returned.
+func (as *AgeRatingCategoryLookupService) Index(opts ...Option) ([]*AgeRatingCategoryLookup, error) {
+	var cat []*AgeRatingCategoryLookup
+
+	err := as.client.post(as.end, &cat, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of AgeRatingCategoryLookups")
+	}
+
+	return cat, nil
+}
+
+// Count returns the number of AgeRatingCategoryLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which AgeRatingCategoryLookups to count.
+func (as *AgeRatingCategoryLookupService) Count(opts ...Option) (int, error) {
+	ct, err := as.client.getCount(as.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count AgeRatingCategoryLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB AgeRatingCategoryLookup object.
+func (as *AgeRatingCategoryLookupService) Fields() ([]string, error) {
+	f, err := as.client.getFields(as.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get AgeRatingCategoryLookup fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,214 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	testAgeRatingCategoryLookupGet  string = "test_data/ageratingcategorylookup_get.json"
+	testAgeRatingCategoryLookupList string = "test_data/ageratingcategorylookup_list.json"
+)
+
+func TestAgeRatingCategoryLookupService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testAgeRatingCategoryLookupGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*AgeRatingCategoryLookup, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                        string
+		file                        string
+		id                          int
+		opts                        []Option
+		wantAgeRatingCategoryLookup *AgeRatingCategoryLookup
+		wantErr                     error
+	}{
+		{"Valid response", testAgeRatingCategoryLookupGet, 1, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			cat, err := c.AgeRatingCategories.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(cat, test.wantAgeRatingCategoryLookup) {
+				t.Errorf("got: <%v>, \nwant: <%v>", cat, test.wantAgeRatingCategoryLookup)
+			}
+		})
+	}
+}
+
+func TestAgeRatingCategoryLookupService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testAgeRatingCategoryLookupList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*AgeRatingCategoryLookup, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                         string
+		file                         string
+		ids                          []int
+		opts                         []Option
+		wantAgeRatingCategoryLookups []*AgeRatingCategoryLookup
+		wantErr                      error
+	}{
+		{"Valid response", testAgeRatingCategoryLookupList, []int{1, 2, 3, 4, 5}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{1, 2, 3, 4, 5}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{1, 2, 3, 4, 5}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			cat, err := c.AgeRatingCategories.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(cat, test.wantAgeRatingCategoryLookups) {
+				t.Errorf("got: <%v>, \nwant: <%v>", cat, test.wantAgeRatingCategoryLookups)
+			}
+		})
+	}
+}
+
+func TestAgeRatingCategoryLookupService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testAgeRatingCategoryLookupList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*AgeRatingCategoryLookup, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                         string
+		file                         string
+		opts                         []Option
+		wantAgeRatingCategoryLookups []*AgeRatingCategoryLookup
+		wantErr                      error
+	}{
+		{"Valid response", testAgeRatingCategoryLookupList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			cat, err := c.AgeRatingCategories.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(cat, test.wantAgeRatingCategoryLookups) {
+				t.Errorf("got: <%v>, \nwant: <%v>", cat, test.wantAgeRatingCategoryLookups)
+			}
+		})
+	}
+}
+
+func TestAgeRatingCategoryLookupService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.AgeRatingCategories.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestAgeRatingCategoryLookupService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.AgeRatingCategories.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
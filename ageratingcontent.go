@@ -10,11 +10,20 @@ import (
 
 // AgeRatingContent is the organization behind a specific rating.
 type AgeRatingContent struct {
+	Presence
+	Extras
 	ID          int                      `json:"id"`
 	Category    AgeRatingContentCategory `json:"category"`
 	Description string                   `json:"description"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the AgeRatingContent and
+// records which of its fields were present or unrecognized.
+func (a *AgeRatingContent) UnmarshalJSON(b []byte) error {
+	type ageRatingContent AgeRatingContent
+	return decodeModel(b, (*ageRatingContent)(a), &a.Presence, &a.Extras)
+}
+
 // AgeRatingContentCategory specifies a regulatory organization.
 type AgeRatingContentCategory int
 
@@ -0,0 +1,114 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct AgeRatingOrganizationLookup -add-tags json -w
+
+// AgeRatingOrganizationLookup represents an age rating organization such as the
+// ESRB or PEGI. It replaces the AgeRatingCategory enums in the IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#age-rating-organization
+type AgeRatingOrganizationLookup struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the AgeRatingOrganizationLookup and
+// records which of its fields were present or unrecognized.
+func (a *AgeRatingOrganizationLookup) UnmarshalJSON(b []byte) error {
+	type ageRatingOrganizationLookup AgeRatingOrganizationLookup
+	return decodeModel(b, (*ageRatingOrganizationLookup)(a), &a.Presence, &a.Extras)
+}
+
+// AgeRatingOrganizationLookupService handles all the API calls for the IGDB AgeRatingOrganizationLookup endpoint.
+type AgeRatingOrganizationLookupService service
+
+// Get returns a single AgeRatingOrganizationLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any AgeRatingOrganizationLookups, an error is returned.
+func (as *AgeRatingOrganizationLookupService) Get(id int, opts ...Option) (*AgeRatingOrganizationLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var org []*AgeRatingOrganizationLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := as.client.post(as.end, &org, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get AgeRatingOrganizationLookup with ID %v", id)
+	}
+
+	return org[0], nil
+}
+
+// List returns a list of AgeRatingOrganizationLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a AgeRatingOrganizationLookup is ignored. If none of the IDs
+// match a AgeRatingOrganizationLookup, an error is returned.
+func (as *AgeRatingOrganizationLookupService) List(ids []int, opts ...Option) ([]*AgeRatingOrganizationLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var org []*AgeRatingOrganizationLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := as.client.post(as.end, &org, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get AgeRatingOrganizationLookups with IDs %v", ids)
+	}
+
+	return org, nil
+}
+
+// Index returns an index of AgeRatingOrganizationLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no AgeRatingOrganizationLookups can
+// be found using the provided options, an error is returned.
+func (as *AgeRatingOrganizationLookupService) Index(opts ...Option) ([]*AgeRatingOrganizationLookup, error) {
+	var org []*AgeRatingOrganizationLookup
+
+	err := as.client.post(as.end, &org, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of AgeRatingOrganizationLookups")
+	}
+
+	return org, nil
+}
+
+// Count returns the number of AgeRatingOrganizationLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which AgeRatingOrganizationLookups to count.
+func (as *AgeRatingOrganizationLookupService) Count(opts ...Option) (int, error) {
+	ct, err := as.client.getCount(as.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count AgeRatingOrganizationLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB AgeRatingOrganizationLookup object.
+func (as *AgeRatingOrganizationLookupService) Fields() ([]string, error) {
+	f, err := as.client.getFields(as.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get AgeRatingOrganizationLookup fields")
+	}
+
+	return f, nil
+}
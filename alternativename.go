@@ -12,12 +12,21 @@ import (
 // name for a particular video game.
 // For more information visit: https://api-docs.igdb.com/#alternative-name
 type AlternativeName struct {
+	Presence
+	Extras
 	ID      int    `json:"id"`
 	Comment string `json:"comment"`
 	Game    int    `json:"game"`
 	Name    string `json:"name"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the AlternativeName and
+// records which of its fields were present or unrecognized.
+func (a *AlternativeName) UnmarshalJSON(b []byte) error {
+	type alternativeName AlternativeName
+	return decodeModel(b, (*alternativeName)(a), &a.Presence, &a.Extras)
+}
+
 // AlternativeNameService handles all the API calls for the IGDB AlternativeName endpoint.
 type AlternativeNameService service
 
@@ -14,10 +14,19 @@ type ArtworkService service
 // For more information visit: https://api-docs.igdb.com/#artwork
 type Artwork struct {
 	Image
+	Presence
+	Extras
 	ID   int `json:"id"`
 	Game int `json:"game"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the Artwork and
+// records which of its fields were present or unrecognized.
+func (a *Artwork) UnmarshalJSON(b []byte) error {
+	type artwork Artwork
+	return decodeModel(b, (*artwork)(a), &a.Presence, &a.Extras)
+}
+
 // Get returns a single Artwork identified by the provided IGDB ID. Provide
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any Artworks, an error is returned.
@@ -11,23 +11,37 @@ import (
 // Character represents a video game character.
 // For more information visit: https://api-docs.igdb.com/#character
 type Character struct {
-	ID          int              `json:"ID"`
-	AKAS        []string         `json:"akas"`
-	CountryName string           `json:"country_name"`
-	CreatedAt   int              `json:"created_at"`
-	Description string           `json:"description"`
-	Games       []int            `json:"games"`
-	Gender      CharacterGender  `json:"gender"`
-	MugShot     int              `json:"mug_shot"`
-	Name        string           `json:"name"`
-	People      []int            `json:"people"`
-	Slug        string           `json:"slug"`
-	Species     CharacterSpecies `json:"species"`
-	UpdatedAt   int              `json:"updated_at"`
-	URL         string           `json:"url"`
+	Presence
+	Extras
+	ID                 int              `json:"id"`
+	AKAS               []string         `json:"akas"`
+	CharacterGenderID  int              `json:"character_gender"`
+	CharacterSpeciesID int              `json:"character_species"`
+	CountryName        string           `json:"country_name"`
+	CreatedAt          Timestamp        `json:"created_at"`
+	Description        string           `json:"description"`
+	Games              []int            `json:"games"`
+	Gender             CharacterGender  `json:"gender"`
+	MugShot            int              `json:"mug_shot"`
+	Name               string           `json:"name"`
+	People             []int            `json:"people"`
+	Slug               string           `json:"slug"`
+	Species            CharacterSpecies `json:"species"`
+	UpdatedAt          Timestamp        `json:"updated_at"`
+	URL                string           `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the Character and
+// records which of its fields were present or unrecognized.
+func (c *Character) UnmarshalJSON(b []byte) error {
+	type character Character
+	return decodeModel(b, (*character)(c), &c.Presence, &c.Extras)
 }
 
 // CharacterGender specifies a specific gender.
+//
+// The IGDB v4 deprecates the gender field of a Character in favor of the
+// character_gender field, which holds a Character Gender ID instead.
 type CharacterGender int
 
 //go:generate stringer -type=CharacterGender,CharacterSpecies
@@ -40,6 +54,9 @@ const (
 )
 
 // CharacterSpecies specifies a specific species.
+//
+// The IGDB v4 deprecates the species field of a Character in favor of the
+// character_species field, which holds a Character Species ID instead.
 type CharacterSpecies int
 
 // Expected CharacterSpecies enums from the IGDB.
@@ -73,6 +90,25 @@ func (cs *CharacterService) Get(id int, opts ...Option) (*Character, error) {
 	return ch[0], nil
 }
 
+// GetBySlug returns a single Character identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Characters, an error is returned.
+func (cs *CharacterService) GetBySlug(slug string, opts ...Option) (*Character, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var ch []*Character
+
+	opts = append(opts, setSlug(slug))
+	err := cs.client.post(cs.end, &ch, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Character with slug %s", slug)
+	}
+
+	return ch[0], nil
+}
+
 // List returns a list of Characters identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Character is ignored. If none of the IDs
@@ -61,6 +61,52 @@ func TestCharacterService_Get(t *testing.T) {
 	}
 }
 
+func TestCharacterService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testCharacterGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Character, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name          string
+		file          string
+		slug          string
+		opts          []Option
+		wantCharacter *Character
+		wantErr       error
+	}{
+		{"Valid response", testCharacterGet, "chad-kensington", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "chad-kensington", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "chad-kensington", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ch, err := c.Characters.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ch, test.wantCharacter) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ch, test.wantCharacter)
+			}
+		})
+	}
+}
+
 func TestCharacterService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testCharacterList)
 	if err != nil {
@@ -0,0 +1,114 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct CharacterGenderLookup -add-tags json -w
+
+// CharacterGenderLookup represents the gender of a character. It replaces
+// the CharacterGender enums in the IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#character-gender
+type CharacterGenderLookup struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the CharacterGenderLookup and
+// records which of its fields were present or unrecognized.
+func (c *CharacterGenderLookup) UnmarshalJSON(b []byte) error {
+	type characterGenderLookup CharacterGenderLookup
+	return decodeModel(b, (*characterGenderLookup)(c), &c.Presence, &c.Extras)
+}
+
+// CharacterGenderLookupService handles all the API calls for the IGDB CharacterGenderLookup endpoint.
+type CharacterGenderLookupService service
+
+// Get returns a single CharacterGenderLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any CharacterGenderLookups, an error is returned.
+func (cs *CharacterGenderLookupService) Get(id int, opts ...Option) (*CharacterGenderLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var gen []*CharacterGenderLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.post(cs.end, &gen, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CharacterGenderLookup with ID %v", id)
+	}
+
+	return gen[0], nil
+}
+
+// List returns a list of CharacterGenderLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a CharacterGenderLookup is ignored. If none of the IDs
+// match a CharacterGenderLookup, an error is returned.
+func (cs *CharacterGenderLookupService) List(ids []int, opts ...Option) ([]*CharacterGenderLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var gen []*CharacterGenderLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := cs.client.post(cs.end, &gen, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CharacterGenderLookups with IDs %v", ids)
+	}
+
+	return gen, nil
+}
+
+// Index returns an index of CharacterGenderLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no CharacterGenderLookups can
+// be found using the provided options, an error is returned.
+func (cs *CharacterGenderLookupService) Index(opts ...Option) ([]*CharacterGenderLookup, error) {
+	var gen []*CharacterGenderLookup
+
+	err := cs.client.post(cs.end, &gen, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of CharacterGenderLookups")
+	}
+
+	return gen, nil
+}
+
+// Count returns the number of CharacterGenderLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which CharacterGenderLookups to count.
+func (cs *CharacterGenderLookupService) Count(opts ...Option) (int, error) {
+	ct, err := cs.client.getCount(cs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count CharacterGenderLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB CharacterGenderLookup object.
+func (cs *CharacterGenderLookupService) Fields() ([]string, error) {
+	f, err := cs.client.getFields(cs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get CharacterGenderLookup fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,214 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	testCharacterGenderLookupGet  string = "test_data/charactergenderlookup_get.json"
+	testCharacterGenderLookupList string = "test_data/charactergenderlookup_list.json"
+)
+
+func TestCharacterGenderLookupService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testCharacterGenderLookupGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CharacterGenderLookup, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                      string
+		file                      string
+		id                        int
+		opts                      []Option
+		wantCharacterGenderLookup *CharacterGenderLookup
+		wantErr                   error
+	}{
+		{"Valid response", testCharacterGenderLookupGet, 1, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			gen, err := c.CharacterGenders.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(gen, test.wantCharacterGenderLookup) {
+				t.Errorf("got: <%v>, \nwant: <%v>", gen, test.wantCharacterGenderLookup)
+			}
+		})
+	}
+}
+
+func TestCharacterGenderLookupService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testCharacterGenderLookupList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CharacterGenderLookup, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                       string
+		file                       string
+		ids                        []int
+		opts                       []Option
+		wantCharacterGenderLookups []*CharacterGenderLookup
+		wantErr                    error
+	}{
+		{"Valid response", testCharacterGenderLookupList, []int{1, 2, 3}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{1, 2, 3}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{1, 2, 3}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			gen, err := c.CharacterGenders.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(gen, test.wantCharacterGenderLookups) {
+				t.Errorf("got: <%v>, \nwant: <%v>", gen, test.wantCharacterGenderLookups)
+			}
+		})
+	}
+}
+
+func TestCharacterGenderLookupService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testCharacterGenderLookupList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CharacterGenderLookup, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                       string
+		file                       string
+		opts                       []Option
+		wantCharacterGenderLookups []*CharacterGenderLookup
+		wantErr                    error
+	}{
+		{"Valid response", testCharacterGenderLookupList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			gen, err := c.CharacterGenders.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(gen, test.wantCharacterGenderLookups) {
+				t.Errorf("got: <%v>, \nwant: <%v>", gen, test.wantCharacterGenderLookups)
+			}
+		})
+	}
+}
+
+func TestCharacterGenderLookupService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.CharacterGenders.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestCharacterGenderLookupService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.CharacterGenders.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
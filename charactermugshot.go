@@ -13,9 +13,18 @@ type CharacterMugshotService service
 // For more information visit: https://api-docs.igdb.com/#character-mug-shot
 type CharacterMugshot struct {
 	Image
+	Presence
+	Extras
 	ID int `json:"id"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the CharacterMugshot and
+// records which of its fields were present or unrecognized.
+func (c *CharacterMugshot) UnmarshalJSON(b []byte) error {
+	type characterMugshot CharacterMugshot
+	return decodeModel(b, (*characterMugshot)(c), &c.Presence, &c.Extras)
+}
+
 // Get returns a single CharacterMugshot identified by the provided IGDB ID. Provide
 // the SetFields functional option if you need to specify which fields to
 // retrieve. If the ID does not match any CharacterMugshots, an error is returned.
@@ -0,0 +1,114 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct CharacterSpeciesLookup -add-tags json -w
+
+// CharacterSpeciesLookup represents the species of a character. It replaces
+// the CharacterSpecies enums in the IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#character-species
+type CharacterSpeciesLookup struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the CharacterSpeciesLookup and
+// records which of its fields were present or unrecognized.
+func (c *CharacterSpeciesLookup) UnmarshalJSON(b []byte) error {
+	type characterSpeciesLookup CharacterSpeciesLookup
+	return decodeModel(b, (*characterSpeciesLookup)(c), &c.Presence, &c.Extras)
+}
+
+// CharacterSpeciesLookupService handles all the API calls for the IGDB CharacterSpeciesLookup endpoint.
+type CharacterSpeciesLookupService service
+
+// Get returns a single CharacterSpeciesLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any CharacterSpeciesLookups, an error is returned.
+func (cs *CharacterSpeciesLookupService) Get(id int, opts ...Option) (*CharacterSpeciesLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var spec []*CharacterSpeciesLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.post(cs.end, &spec, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CharacterSpeciesLookup with ID %v", id)
+	}
+
+	return spec[0], nil
+}
+
+// List returns a list of CharacterSpeciesLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a CharacterSpeciesLookup is ignored. If none of the IDs
+// match a CharacterSpeciesLookup, an error is returned.
+func (cs *CharacterSpeciesLookupService) List(ids []int, opts ...Option) ([]*CharacterSpeciesLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var spec []*CharacterSpeciesLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := cs.client.post(cs.end, &spec, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CharacterSpeciesLookups with IDs %v", ids)
+	}
+
+	return spec, nil
+}
+
+// Index returns an index of CharacterSpeciesLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no CharacterSpeciesLookups can
+// be found using the provided options, an error is returned.
+func (cs *CharacterSpeciesLookupService) Index(opts ...Option) ([]*CharacterSpeciesLookup, error) {
+	var spec []*CharacterSpeciesLookup
+
+	err := cs.client.post(cs.end, &spec, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of CharacterSpeciesLookups")
+	}
+
+	return spec, nil
+}
+
+// Count returns the number of CharacterSpeciesLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which CharacterSpeciesLookups to count.
+func (cs *CharacterSpeciesLookupService) Count(opts ...Option) (int, error) {
+	ct, err := cs.client.getCount(cs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count CharacterSpeciesLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB CharacterSpeciesLookup object.
+func (cs *CharacterSpeciesLookupService) Fields() ([]string, error) {
+	f, err := cs.client.getFields(cs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get CharacterSpeciesLookup fields")
+	}
+
+	return f, nil
+}
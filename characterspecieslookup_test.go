@@ -0,0 +1,214 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	testCharacterSpeciesLookupGet  string = "test_data/characterspecieslookup_get.json"
+	testCharacterSpeciesLookupList string = "test_data/characterspecieslookup_list.json"
+)
+
+func TestCharacterSpeciesLookupService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testCharacterSpeciesLookupGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CharacterSpeciesLookup, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                       string
+		file                       string
+		id                         int
+		opts                       []Option
+		wantCharacterSpeciesLookup *CharacterSpeciesLookup
+		wantErr                    error
+	}{
+		{"Valid response", testCharacterSpeciesLookupGet, 1, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			spec, err := c.CharacterSpecies.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(spec, test.wantCharacterSpeciesLookup) {
+				t.Errorf("got: <%v>, \nwant: <%v>", spec, test.wantCharacterSpeciesLookup)
+			}
+		})
+	}
+}
+
+func TestCharacterSpeciesLookupService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testCharacterSpeciesLookupList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CharacterSpeciesLookup, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                        string
+		file                        string
+		ids                         []int
+		opts                        []Option
+		wantCharacterSpeciesLookups []*CharacterSpeciesLookup
+		wantErr                     error
+	}{
+		{"Valid response", testCharacterSpeciesLookupList, []int{1, 2, 3, 4, 5}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{1, 2, 3, 4, 5}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{1, 2, 3, 4, 5}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			spec, err := c.CharacterSpecies.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(spec, test.wantCharacterSpeciesLookups) {
+				t.Errorf("got: <%v>, \nwant: <%v>", spec, test.wantCharacterSpeciesLookups)
+			}
+		})
+	}
+}
+
+func TestCharacterSpeciesLookupService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testCharacterSpeciesLookupList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CharacterSpeciesLookup, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                        string
+		file                        string
+		opts                        []Option
+		wantCharacterSpeciesLookups []*CharacterSpeciesLookup
+		wantErr                     error
+	}{
+		{"Valid response", testCharacterSpeciesLookupList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			spec, err := c.CharacterSpecies.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(spec, test.wantCharacterSpeciesLookups) {
+				t.Errorf("got: <%v>, \nwant: <%v>", spec, test.wantCharacterSpeciesLookups)
+			}
+		})
+	}
+}
+
+func TestCharacterSpeciesLookupService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.CharacterSpecies.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestCharacterSpeciesLookupService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.CharacterSpecies.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
@@ -11,12 +11,26 @@ import (
 // Collection represents a video game series.
 // For more information visit: https://api-docs.igdb.com/#collection
 type Collection struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	Presence
+	Extras
+	ID                int       `json:"id"`
+	AsChildRelations  []int     `json:"as_child_relations"`
+	AsParentRelations []int     `json:"as_parent_relations"`
+	Checksum          string    `json:"checksum"`
+	CreatedAt         Timestamp `json:"created_at"`
+	Games             []int     `json:"games"`
+	Name              string    `json:"name"`
+	Slug              string    `json:"slug"`
+	Type              int       `json:"type"`
+	UpdatedAt         Timestamp `json:"updated_at"`
+	URL               string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the Collection and
+// records which of its fields were present or unrecognized.
+func (c *Collection) UnmarshalJSON(b []byte) error {
+	type collection Collection
+	return decodeModel(b, (*collection)(c), &c.Presence, &c.Extras)
 }
 
 // CollectionService handles all the API calls for the IGDB Collection endpoint.
@@ -41,6 +55,25 @@ func (cs *CollectionService) Get(id int, opts ...Option) (*Collection, error) {
 	return col[0], nil
 }
 
+// GetBySlug returns a single Collection identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Collections, an error is returned.
+func (cs *CollectionService) GetBySlug(slug string, opts ...Option) (*Collection, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var col []*Collection
+
+	opts = append(opts, setSlug(slug))
+	err := cs.client.post(cs.end, &col, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Collection with slug %s", slug)
+	}
+
+	return col[0], nil
+}
+
 // List returns a list of Collections identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Collection is ignored. If none of the IDs
@@ -118,3 +151,149 @@ func (cs *CollectionService) Fields() ([]string, error) {
 
 	return f, nil
 }
+
+// CollectionMember contains a Game that is a member of a Collection along
+// with its CollectionMembership and the CollectionMembershipType of it.
+type CollectionMember struct {
+	Game       *Game                     `json:"game"`
+	Membership *CollectionMembership     `json:"membership"`
+	Type       *CollectionMembershipType `json:"type,omitempty"`
+}
+
+// Members returns the member Games of the Collection identified by the
+// provided IGDB ID along with their memberships and membership types, in
+// the order the memberships were added. Memberships referencing Games that
+// cannot be found are skipped. If the Collection has no members, an error
+// is returned.
+func (cs *CollectionService) Members(id int) ([]*CollectionMember, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var mem []*CollectionMembership
+	err := cs.client.postAll(EndpointCollectionMembership, &mem,
+		SetFields("*"),
+		SetFilter("collection", OpEquals, strconv.Itoa(id)),
+		SetOrder("id", OrderAscending),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get members of Collection with ID %v", id)
+	}
+
+	var gameIDs, typeIDs []int
+	for _, m := range mem {
+		gameIDs = append(gameIDs, m.Game)
+		typeIDs = append(typeIDs, m.Type)
+	}
+
+	games, err := cs.client.getByIDs(EndpointGame, gameIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get member Games of Collection with ID %v", id)
+	}
+
+	types, err := cs.client.getByIDs(EndpointCollectionMembershipType, typeIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get membership types of Collection with ID %v", id)
+	}
+
+	members := make([]*CollectionMember, 0, len(mem))
+	for _, m := range mem {
+		g, ok := games[m.Game]
+		if !ok {
+			continue
+		}
+
+		cm := &CollectionMember{Game: g.Interface().(*Game), Membership: m}
+		if t, ok := types[m.Type]; ok {
+			cm.Type = t.Interface().(*CollectionMembershipType)
+		}
+		members = append(members, cm)
+	}
+
+	if len(members) == 0 {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get members of Collection with ID %v", id)
+	}
+
+	return members, nil
+}
+
+// RelatedCollection contains a Collection related to another Collection
+// along with their CollectionRelation and the CollectionRelationType of it.
+type RelatedCollection struct {
+	Collection *Collection             `json:"collection"`
+	Relation   *CollectionRelation     `json:"relation"`
+	Type       *CollectionRelationType `json:"type,omitempty"`
+}
+
+// CollectionRelatives contains the parent and child Collections of a
+// particular Collection.
+type CollectionRelatives struct {
+	Parents  []*RelatedCollection `json:"parents"`
+	Children []*RelatedCollection `json:"children"`
+}
+
+// Relations returns the parent and child Collections of the Collection
+// identified by the provided IGDB ID along with their relations and relation
+// types. Relations referencing Collections that cannot be found are skipped.
+// If the Collection has no relations, an error is returned.
+func (cs *CollectionService) Relations(id int) (*CollectionRelatives, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var rels []*CollectionRelation
+	err := cs.client.postAll(EndpointCollectionRelation, &rels,
+		SetFields("*"),
+		setFilterAny(OpEquals, []string{strconv.Itoa(id)}, "parent_collection", "child_collection"),
+		SetOrder("id", OrderAscending),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get relations of Collection with ID %v", id)
+	}
+
+	if len(rels) == 0 {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get relations of Collection with ID %v", id)
+	}
+
+	var colIDs, typeIDs []int
+	for _, r := range rels {
+		colIDs = append(colIDs, r.ParentCollection, r.ChildCollection)
+		typeIDs = append(typeIDs, r.Type)
+	}
+
+	cols, err := cs.client.getByIDs(cs.end, colIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get related Collections of Collection with ID %v", id)
+	}
+
+	types, err := cs.client.getByIDs(EndpointCollectionRelationType, typeIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get relation types of Collection with ID %v", id)
+	}
+
+	rc := &CollectionRelatives{}
+	for _, r := range rels {
+		other := r.ParentCollection
+		if other == id {
+			other = r.ChildCollection
+		}
+
+		c, ok := cols[other]
+		if !ok {
+			continue
+		}
+
+		rel := &RelatedCollection{Collection: c.Interface().(*Collection), Relation: r}
+		if t, ok := types[r.Type]; ok {
+			rel.Type = t.Interface().(*CollectionRelationType)
+		}
+
+		if r.ChildCollection == id {
+			rc.Parents = append(rc.Parents, rel)
+		} else {
+			rc.Children = append(rc.Children, rel)
+		}
+	}
+
+	return rc, nil
+}
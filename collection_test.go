@@ -61,6 +61,52 @@ func TestCollectionService_Get(t *testing.T) {
 	}
 }
 
+func TestCollectionService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testCollectionGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Collection, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name           string
+		file           string
+		slug           string
+		opts           []Option
+		wantCollection *Collection
+		wantErr        error
+	}{
+		{"Valid response", testCollectionGet, "ratchet-clank", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "ratchet-clank", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "ratchet-clank", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			col, err := c.Collections.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(col, test.wantCollection) {
+				t.Errorf("got: <%v>, \nwant: <%v>", col, test.wantCollection)
+			}
+		})
+	}
+}
+
 func TestCollectionService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testCollectionList)
 	if err != nil {
@@ -258,3 +304,110 @@ func TestCollectionService_Fields(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectionService_Members(t *testing.T) {
+	mem, err := ioutil.ReadFile(testCollectionMembershipList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	types, err := ioutil.ReadFile(testCollectionMembershipTypeList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		mem       string
+		id        int
+		wantGames []int
+		wantTypes []string
+		wantErr   error
+	}{
+		{"Valid response", string(mem), 286, []int{1289, 1770, 11065, 65418}, []string{"Main Entry", "Main Entry", "Compilation", "Spin-off"}, nil},
+		{"Invalid ID", "[]", -1, nil, nil, ErrNegativeID},
+		{"No results", "[]", 286, nil, nil, ErrNoResults},
+		{"Empty response", "", 286, nil, nil, errInvalidJSON},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRoutes(map[string]string{
+				"/collection_memberships/":      test.mem,
+				"/collection_membership_types/": string(types),
+				"/games/":                       `[{"id": 1289}, {"id": 1770}, {"id": 11065}, {"id": 65418}]`,
+			})
+			defer ts.Close()
+
+			members, err := c.Collections.Members(test.id)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			var games []int
+			var typeNames []string
+			for _, m := range members {
+				games = append(games, m.Game.ID)
+				typeNames = append(typeNames, m.Type.Name)
+			}
+
+			if !reflect.DeepEqual(games, test.wantGames) || !reflect.DeepEqual(typeNames, test.wantTypes) {
+				t.Errorf("got: <%v, %v>, want: <%v, %v>", games, typeNames, test.wantGames, test.wantTypes)
+			}
+		})
+	}
+}
+
+func TestCollectionService_Relations(t *testing.T) {
+	types, err := ioutil.ReadFile(testCollectionRelationTypeList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts, c := testServerRoutes(map[string]string{
+		"/collection_relations/":      `[{"id": 311, "child_collection": 286, "parent_collection": 4062, "type": 1}, {"id": 312, "child_collection": 3207, "parent_collection": 286, "type": 2}, {"id": 313, "child_collection": 404, "parent_collection": 286, "type": 2}]`,
+		"/collection_relation_types/": string(types),
+		"/collections/":               `[{"id": 4062, "name": "PlayStation Universe"}, {"id": 3207, "name": "Ratchet & Clank: Going Mobile"}]`,
+	})
+	defer ts.Close()
+
+	rc, err := c.Collections.Relations(286)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rc.Parents) != 1 || rc.Parents[0].Collection.ID != 4062 || rc.Parents[0].Type.Name != "Sub-series" {
+		t.Errorf("got: <%v>, want: <parent collection 4062>", rc.Parents)
+	}
+
+	if len(rc.Children) != 1 || rc.Children[0].Collection.ID != 3207 || rc.Children[0].Relation.ID != 312 {
+		t.Errorf("got: <%v>, want: <child collection 3207>", rc.Children)
+	}
+
+	var tests = []struct {
+		name    string
+		resp    string
+		id      int
+		wantErr error
+	}{
+		{"Invalid ID", "[]", -1, ErrNegativeID},
+		{"No results", "[]", 286, ErrNoResults},
+		{"Empty response", "", 286, errInvalidJSON},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			rc, err := c.Collections.Relations(test.id)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if rc != nil {
+				t.Errorf("got: <%v>, want: <nil>", rc)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct CollectionMembershipType -add-tags json -w
+
+// CollectionMembershipType represents a kind of membership a game can
+// have in a collection, such as a main entry or a spin-off.
+// For more information visit: https://api-docs.igdb.com/#collection-membership-type
+type CollectionMembershipType struct {
+	Presence
+	Extras
+	ID                    int       `json:"id"`
+	AllowedCollectionType int       `json:"allowed_collection_type"`
+	Checksum              string    `json:"checksum"`
+	CreatedAt             Timestamp `json:"created_at"`
+	Description           string    `json:"description"`
+	Name                  string    `json:"name"`
+	UpdatedAt             Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the CollectionMembershipType and
+// records which of its fields were present or unrecognized.
+func (c *CollectionMembershipType) UnmarshalJSON(b []byte) error {
+	type collectionMembershipType CollectionMembershipType
+	return decodeModel(b, (*collectionMembershipType)(c), &c.Presence, &c.Extras)
+}
+
+// CollectionMembershipTypeService handles all the API calls for the IGDB CollectionMembershipType endpoint.
+type CollectionMembershipTypeService service
+
+// Get returns a single CollectionMembershipType identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any CollectionMembershipTypes, an error is returned.
+func (cs *CollectionMembershipTypeService) Get(id int, opts ...Option) (*CollectionMembershipType, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var typ []*CollectionMembershipType
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.post(cs.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CollectionMembershipType with ID %v", id)
+	}
+
+	return typ[0], nil
+}
+
+// List returns a list of CollectionMembershipTypes identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a CollectionMembershipType is ignored. If none of the IDs
+// match a CollectionMembershipType, an error is returned.
+func (cs *CollectionMembershipTypeService) List(ids []int, opts ...Option) ([]*CollectionMembershipType, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var typ []*CollectionMembershipType
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := cs.client.post(cs.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CollectionMembershipTypes with IDs %v", ids)
+	}
+
+	return typ, nil
+}
+
+// Index returns an index of CollectionMembershipTypes based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no CollectionMembershipTypes can
+// be found using the provided options, an error is returned.
+func (cs *CollectionMembershipTypeService) Index(opts ...Option) ([]*CollectionMembershipType, error) {
+	var typ []*CollectionMembershipType
+
+	err := cs.client.post(cs.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of CollectionMembershipTypes")
+	}
+
+	return typ, nil
+}
+
+// Count returns the number of CollectionMembershipTypes available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which CollectionMembershipTypes to count.
+func (cs *CollectionMembershipTypeService) Count(opts ...Option) (int, error) {
+	ct, err := cs.client.getCount(cs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count CollectionMembershipTypes")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB CollectionMembershipType object.
+func (cs *CollectionMembershipTypeService) Fields() ([]string, error) {
+	f, err := cs.client.getFields(cs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get CollectionMembershipType fields")
+	}
+
+	return f, nil
+}
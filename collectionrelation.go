@@ -0,0 +1,116 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct CollectionRelation -add-tags json -w
+
+// CollectionRelation represents the relationship between a parent
+// collection and a child collection.
+// For more information visit: https://api-docs.igdb.com/#collection-relation
+type CollectionRelation struct {
+	Presence
+	Extras
+	ID               int       `json:"id"`
+	Checksum         string    `json:"checksum"`
+	ChildCollection  int       `json:"child_collection"`
+	CreatedAt        Timestamp `json:"created_at"`
+	ParentCollection int       `json:"parent_collection"`
+	Type             int       `json:"type"`
+	UpdatedAt        Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the CollectionRelation and
+// records which of its fields were present or unrecognized.
+func (c *CollectionRelation) UnmarshalJSON(b []byte) error {
+	type collectionRelation CollectionRelation
+	return decodeModel(b, (*collectionRelation)(c), &c.Presence, &c.Extras)
+}
+
+// CollectionRelationService handles all the API calls for the IGDB CollectionRelation endpoint.
+type CollectionRelationService service
+
+// Get returns a single CollectionRelation identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any CollectionRelations, an error is returned.
+func (cs *CollectionRelationService) Get(id int, opts ...Option) (*CollectionRelation, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var rel []*CollectionRelation
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.post(cs.end, &rel, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CollectionRelation with ID %v", id)
+	}
+
+	return rel[0], nil
+}
+
+// List returns a list of CollectionRelations identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a CollectionRelation is ignored. If none of the IDs
+// match a CollectionRelation, an error is returned.
+func (cs *CollectionRelationService) List(ids []int, opts ...Option) ([]*CollectionRelation, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var rel []*CollectionRelation
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := cs.client.post(cs.end, &rel, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CollectionRelations with IDs %v", ids)
+	}
+
+	return rel, nil
+}
+
+// Index returns an index of CollectionRelations based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no CollectionRelations can
+// be found using the provided options, an error is returned.
+func (cs *CollectionRelationService) Index(opts ...Option) ([]*CollectionRelation, error) {
+	var rel []*CollectionRelation
+
+	err := cs.client.post(cs.end, &rel, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of CollectionRelations")
+	}
+
+	return rel, nil
+}
+
+// Count returns the number of CollectionRelations available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which CollectionRelations to count.
+func (cs *CollectionRelationService) Count(opts ...Option) (int, error) {
+	ct, err := cs.client.getCount(cs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count CollectionRelations")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB CollectionRelation object.
+func (cs *CollectionRelationService) Fields() ([]string, error) {
+	f, err := cs.client.getFields(cs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get CollectionRelation fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,214 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	testCollectionRelationGet  string = "test_data/collectionrelation_get.json"
+	testCollectionRelationList string = "test_data/collectionrelation_list.json"
+)
+
+func TestCollectionRelationService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testCollectionRelationGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CollectionRelation, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                   string
+		file                   string
+		id                     int
+		opts                   []Option
+		wantCollectionRelation *CollectionRelation
+		wantErr                error
+	}{
+		{"Valid response", testCollectionRelationGet, 311, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 311, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 311, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			rel, err := c.CollectionRelations.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(rel, test.wantCollectionRelation) {
+				t.Errorf("got: <%v>, \nwant: <%v>", rel, test.wantCollectionRelation)
+			}
+		})
+	}
+}
+
+func TestCollectionRelationService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testCollectionRelationList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CollectionRelation, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                    string
+		file                    string
+		ids                     []int
+		opts                    []Option
+		wantCollectionRelations []*CollectionRelation
+		wantErr                 error
+	}{
+		{"Valid response", testCollectionRelationList, []int{311, 312, 313, 314, 315}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{311, 312, 313, 314, 315}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{311, 312, 313, 314, 315}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			rel, err := c.CollectionRelations.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(rel, test.wantCollectionRelations) {
+				t.Errorf("got: <%v>, \nwant: <%v>", rel, test.wantCollectionRelations)
+			}
+		})
+	}
+}
+
+func TestCollectionRelationService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testCollectionRelationList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*CollectionRelation, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                    string
+		file                    string
+		opts                    []Option
+		wantCollectionRelations []*CollectionRelation
+		wantErr                 error
+	}{
+		{"Valid response", testCollectionRelationList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			rel, err := c.CollectionRelations.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(rel, test.wantCollectionRelations) {
+				t.Errorf("got: <%v>, \nwant: <%v>", rel, test.wantCollectionRelations)
+			}
+		})
+	}
+}
+
+func TestCollectionRelationService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.CollectionRelations.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestCollectionRelationService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.CollectionRelations.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
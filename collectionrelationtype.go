@@ -0,0 +1,117 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct CollectionRelationType -add-tags json -w
+
+// CollectionRelationType represents a kind of relationship between two
+// collections, such as a series and its sub-series.
+// For more information visit: https://api-docs.igdb.com/#collection-relation-type
+type CollectionRelationType struct {
+	Presence
+	Extras
+	ID                int       `json:"id"`
+	AllowedChildType  int       `json:"allowed_child_type"`
+	AllowedParentType int       `json:"allowed_parent_type"`
+	Checksum          string    `json:"checksum"`
+	CreatedAt         Timestamp `json:"created_at"`
+	Description       string    `json:"description"`
+	Name              string    `json:"name"`
+	UpdatedAt         Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the CollectionRelationType and
+// records which of its fields were present or unrecognized.
+func (c *CollectionRelationType) UnmarshalJSON(b []byte) error {
+	type collectionRelationType CollectionRelationType
+	return decodeModel(b, (*collectionRelationType)(c), &c.Presence, &c.Extras)
+}
+
+// CollectionRelationTypeService handles all the API calls for the IGDB CollectionRelationType endpoint.
+type CollectionRelationTypeService service
+
+// Get returns a single CollectionRelationType identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any CollectionRelationTypes, an error is returned.
+func (cs *CollectionRelationTypeService) Get(id int, opts ...Option) (*CollectionRelationType, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var typ []*CollectionRelationType
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := cs.client.post(cs.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CollectionRelationType with ID %v", id)
+	}
+
+	return typ[0], nil
+}
+
+// List returns a list of CollectionRelationTypes identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a CollectionRelationType is ignored. If none of the IDs
+// match a CollectionRelationType, an error is returned.
+func (cs *CollectionRelationTypeService) List(ids []int, opts ...Option) ([]*CollectionRelationType, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var typ []*CollectionRelationType
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := cs.client.post(cs.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get CollectionRelationTypes with IDs %v", ids)
+	}
+
+	return typ, nil
+}
+
+// Index returns an index of CollectionRelationTypes based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no CollectionRelationTypes can
+// be found using the provided options, an error is returned.
+func (cs *CollectionRelationTypeService) Index(opts ...Option) ([]*CollectionRelationType, error) {
+	var typ []*CollectionRelationType
+
+	err := cs.client.post(cs.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of CollectionRelationTypes")
+	}
+
+	return typ, nil
+}
+
+// Count returns the number of CollectionRelationTypes available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which CollectionRelationTypes to count.
+func (cs *CollectionRelationTypeService) Count(opts ...Option) (int, error) {
+	ct, err := cs.client.getCount(cs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count CollectionRelationTypes")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB CollectionRelationType object.
+func (cs *CollectionRelationTypeService) Fields() ([]string, error) {
+	f, err := cs.client.getFields(cs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get CollectionRelationType fields")
+	}
+
+	return f, nil
+}
@@ -12,12 +12,14 @@ import (
 // This includes both publishers and developers.
 // For more information visit: https://api-docs.igdb.com/#company
 type Company struct {
+	Presence
+	Extras
 	ID                 int          `json:"id"`
-	ChangeDate         int          `json:"change_date"`
+	ChangeDate         Timestamp    `json:"change_date"`
 	ChangeDateCategory DateCategory `json:"change_date_category"`
 	ChangedCompanyID   int          `json:"changed_company_id"`
 	Country            int          `json:"country"`
-	CreatedAt          int          `json:"created_at"`
+	CreatedAt          Timestamp    `json:"created_at"`
 	Description        string       `json:"description"`
 	Developed          []int        `json:"developed"`
 	Logo               int          `json:"logo"`
@@ -25,13 +27,20 @@ type Company struct {
 	Parent             int          `json:"parent"`
 	Published          []int        `json:"published"`
 	Slug               string       `json:"slug"`
-	StartDate          int          `json:"start_date"`
+	StartDate          Timestamp    `json:"start_date"`
 	StartDateCategory  DateCategory `json:"start_date_category"`
-	UpdatedAt          int          `json:"updated_at"`
+	UpdatedAt          Timestamp    `json:"updated_at"`
 	URL                string       `json:"url"`
 	Websites           []int        `json:"websites"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the Company and
+// records which of its fields were present or unrecognized.
+func (c *Company) UnmarshalJSON(b []byte) error {
+	type company Company
+	return decodeModel(b, (*company)(c), &c.Presence, &c.Extras)
+}
+
 // CompanyService handles all the API calls for the IGDB Company endpoint.
 type CompanyService service
 
@@ -54,6 +63,25 @@ func (cs *CompanyService) Get(id int, opts ...Option) (*Company, error) {
 	return comp[0], nil
 }
 
+// GetBySlug returns a single Company identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Companies, an error is returned.
+func (cs *CompanyService) GetBySlug(slug string, opts ...Option) (*Company, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var comp []*Company
+
+	opts = append(opts, setSlug(slug))
+	err := cs.client.post(cs.end, &comp, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Company with slug %s", slug)
+	}
+
+	return comp[0], nil
+}
+
 // List returns a list of Companies identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Company is ignored. If none of the IDs
@@ -94,6 +122,21 @@ func (cs *CompanyService) Index(opts ...Option) ([]*Company, error) {
 	return comp, nil
 }
 
+// Search returns a list of Companies found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no Companies are found using the provided query, an error is returned.
+func (cs *CompanyService) Search(qry string, opts ...Option) ([]*Company, error) {
+	var comp []*Company
+
+	opts = append(opts, setSearch(qry))
+	err := cs.client.post(cs.end, &comp, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Company with query %s", qry)
+	}
+
+	return comp, nil
+}
+
 // Count returns the number of Companies available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which Companies to count.
@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	testCompanyGet  string = "test_data/company_get.json"
-	testCompanyList string = "test_data/company_list.json"
+	testCompanyGet    string = "test_data/company_get.json"
+	testCompanyList   string = "test_data/company_list.json"
+	testCompanySearch string = "test_data/company_search.json"
 )
 
 func TestCompanyService_Get(t *testing.T) {
@@ -60,6 +61,52 @@ func TestCompanyService_Get(t *testing.T) {
 	}
 }
 
+func TestCompanyService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testCompanyGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Company, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name        string
+		file        string
+		slug        string
+		opts        []Option
+		wantCompany *Company
+		wantErr     error
+	}{
+		{"Valid response", testCompanyGet, "tomolo-games", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "tomolo-games", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "tomolo-games", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			comp, err := c.Companies.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(comp, test.wantCompany) {
+				t.Errorf("got: <%v>, \nwant: <%v>", comp, test.wantCompany)
+			}
+		})
+	}
+}
+
 func TestCompanyService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testCompanyList)
 	if err != nil {
@@ -151,6 +198,52 @@ func TestCompanyService_Index(t *testing.T) {
 	}
 }
 
+func TestCompanyService_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(testCompanySearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Company, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name          string
+		file          string
+		qry           string
+		opts          []Option
+		wantCompanies []*Company
+		wantErr       error
+	}{
+		{"Valid response", testCompanySearch, "big", []Option{SetLimit(50)}, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "big", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "big", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			comp, err := c.Companies.Search(test.qry, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(comp, test.wantCompanies) {
+				t.Errorf("got: <%v>, \nwant: <%v>", comp, test.wantCompanies)
+			}
+		})
+	}
+}
+
 func TestCompanyService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
@@ -10,9 +10,18 @@ import (
 // For more information visit: https://api-docs.igdb.com/#company-logo
 type CompanyLogo struct {
 	Image
+	Presence
+	Extras
 	ID int `json:"id"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the CompanyLogo and
+// records which of its fields were present or unrecognized.
+func (c *CompanyLogo) UnmarshalJSON(b []byte) error {
+	type companyLogo CompanyLogo
+	return decodeModel(b, (*companyLogo)(c), &c.Presence, &c.Extras)
+}
+
 // CompanyLogoService handles all the API calls for the IGDB CompanyLogo endpoint.
 type CompanyLogoService service
 
@@ -11,12 +11,21 @@ import (
 // CompanyWebsite represents a website for a specific company.
 // For more information visit: https://api-docs.igdb.com/#company-website
 type CompanyWebsite struct {
+	Presence
+	Extras
 	ID       int             `json:"id"`
 	Category WebsiteCategory `json:"category"`
 	Trusted  bool            `json:"trusted"`
 	URL      string          `json:"url"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the CompanyWebsite and
+// records which of its fields were present or unrecognized.
+func (c *CompanyWebsite) UnmarshalJSON(b []byte) error {
+	type companyWebsite CompanyWebsite
+	return decodeModel(b, (*companyWebsite)(c), &c.Presence, &c.Extras)
+}
+
 // CompanyWebsiteService handles all the API calls for the IGDB CompanyWebsite endpoint.
 type CompanyWebsiteService service
 
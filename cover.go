@@ -10,10 +10,19 @@ import (
 // For more information visit: https://api-docs.igdb.com/#cover
 type Cover struct {
 	Image
+	Presence
+	Extras
 	ID   int `json:"id"`
 	Game int `json:"game"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the Cover and
+// records which of its fields were present or unrecognized.
+func (c *Cover) UnmarshalJSON(b []byte) error {
+	type cover Cover
+	return decodeModel(b, (*cover)(c), &c.Presence, &c.Extras)
+}
+
 // CoverService handles all the API calls for the IGDB Cover endpoint.
 type CoverService service
 
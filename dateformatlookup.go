@@ -0,0 +1,115 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct DateFormatLookup -add-tags json -w
+
+// DateFormatLookup represents the precision a release date is known with such
+// as a full date or only a year. It replaces the DateCategory enums in the
+// IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#date-format
+type DateFormatLookup struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Format    string    `json:"format"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the DateFormatLookup and
+// records which of its fields were present or unrecognized.
+func (d *DateFormatLookup) UnmarshalJSON(b []byte) error {
+	type dateFormatLookup DateFormatLookup
+	return decodeModel(b, (*dateFormatLookup)(d), &d.Presence, &d.Extras)
+}
+
+// DateFormatLookupService handles all the API calls for the IGDB DateFormatLookup endpoint.
+type DateFormatLookupService service
+
+// Get returns a single DateFormatLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any DateFormatLookups, an error is returned.
+func (ds *DateFormatLookupService) Get(id int, opts ...Option) (*DateFormatLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var form []*DateFormatLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ds.client.post(ds.end, &form, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get DateFormatLookup with ID %v", id)
+	}
+
+	return form[0], nil
+}
+
+// List returns a list of DateFormatLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a DateFormatLookup is ignored. If none of the IDs
+// match a DateFormatLookup, an error is returned.
+func (ds *DateFormatLookupService) List(ids []int, opts ...Option) ([]*DateFormatLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var form []*DateFormatLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ds.client.post(ds.end, &form, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get DateFormatLookups with IDs %v", ids)
+	}
+
+	return form, nil
+}
+
+// Index returns an index of DateFormatLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no DateFormatLookups can
+// be found using the provided options, an error is returned.
+func (ds *DateFormatLookupService) Index(opts ...Option) ([]*DateFormatLookup, error) {
+	var form []*DateFormatLookup
+
+	err := ds.client.post(ds.end, &form, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of DateFormatLookups")
+	}
+
+	return form, nil
+}
+
+// Count returns the number of DateFormatLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which DateFormatLookups to count.
+func (ds *DateFormatLookupService) Count(opts ...Option) (int, error) {
+	ct, err := ds.client.getCount(ds.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count DateFormatLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB DateFormatLookup object.
+func (ds *DateFormatLookupService) Fields() ([]string, error) {
+	f, err := ds.client.getFields(ds.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get DateFormatLookup fields")
+	}
+
+	return f, nil
+}
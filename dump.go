@@ -0,0 +1,396 @@
+package igdb
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// endpointDump is the IGDB API endpoint used to retrieve the
+// CSV data dumps available to the user's IGDB API key.
+const endpointDump endpoint = "dumps/"
+
+var (
+	// ErrInvalidDumpTarget occurs when a data dump is parsed into a value
+	// that is not a pointer to a struct or a pointer to a slice of them.
+	ErrInvalidDumpTarget = errors.New("dump target must be a pointer to a struct or a pointer to a slice of struct pointers")
+	// ErrInvalidDumpArray occurs when an array column of a data dump is
+	// not enclosed in braces, such as {1,2,3}.
+	ErrInvalidDumpArray = errors.New("dump array column must be enclosed in braces")
+	// ErrDumpSize occurs when a downloaded data dump file
+	// does not have the size reported by its Dump.
+	ErrDumpSize = errors.New("dump file size does not match the size of the Dump")
+)
+
+// Dump represents a CSV data dump of an IGDB endpoint. Dumps returned by the
+// List function only have their Endpoint, FileName, and UpdatedAt populated.
+// Dumps returned by the Get function also have a signed URL to download the
+// dump from, its size, and the schema of its columns.
+// For more information visit: https://api-docs.igdb.com/#data-dumps
+type Dump struct {
+	Endpoint      string            `json:"endpoint"`
+	FileName      string            `json:"file_name"`
+	S3URL         string            `json:"s3_url"`
+	SizeBytes     int64             `json:"size_bytes"`
+	UpdatedAt     Timestamp         `json:"updated_at"`
+	SchemaVersion string            `json:"schema_version"`
+	Schema        map[string]string `json:"schema"`
+}
+
+// DumpService handles all the API calls for the IGDB data dumps.
+type DumpService service
+
+// List returns every Dump available to the user's IGDB API key. If no
+// Dumps are available, an error is returned.
+func (ds *DumpService) List() ([]*Dump, error) {
+	var dumps []*Dump
+	if err := ds.get(string(ds.end), &dumps); err != nil {
+		return nil, errors.Wrap(err, "cannot get list of Dumps")
+	}
+
+	if len(dumps) == 0 {
+		return nil, errors.Wrap(ErrNoResults, "cannot get list of Dumps")
+	}
+
+	return dumps, nil
+}
+
+// Get returns the Dump of the provided endpoint, including a freshly signed
+// URL to download it from. Signed URLs expire shortly after being issued, so
+// call Get again before resuming a download that was interrupted long ago.
+func (ds *DumpService) Get(end endpoint) (*Dump, error) {
+	if _, ok := modelTypes[end]; !ok {
+		return nil, errors.Wrapf(ErrUnknownEndpoint, "cannot get Dump of '%s' endpoint", end)
+	}
+
+	var d *Dump
+	if err := ds.get(string(ds.end)+strings.TrimSuffix(string(end), "/"), &d); err != nil {
+		return nil, errors.Wrapf(err, "cannot get Dump of '%s' endpoint", end)
+	}
+
+	if d == nil {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get Dump of '%s' endpoint", end)
+	}
+
+	return d, nil
+}
+
+// get sends a GET request to the provided path and stores
+// the response in the value pointed to by result.
+func (ds *DumpService) get(path string, result interface{}) error {
+	req, err := http.NewRequest("GET", ds.client.rootURL+path, nil)
+	if err != nil {
+		return errors.Wrapf(err, "cannot make GET request for '%s'", path)
+	}
+	ds.client.setHeaders(req)
+
+	if err = ds.client.send(req, result); err != nil {
+		return errors.Wrap(err, "cannot make GET request")
+	}
+
+	return nil
+}
+
+// Download downloads the provided Dump from its signed URL to the file with
+// the provided name. If the file already holds the beginning of the Dump,
+// such as after an interrupted download, only the remaining bytes are
+// requested and appended to it. If the file already holds the entire Dump,
+// nothing is downloaded. The file must not hold the data of any other Dump.
+// If the size of the file does not match the size of the Dump afterwards,
+// such as when the file held more than the entire Dump, ErrDumpSize is
+// returned.
+func (ds *DumpService) Download(d *Dump, filename string) error {
+	if d == nil || d.S3URL == "" {
+		return errors.New("cannot download Dump without a signed URL")
+	}
+
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "cannot open file '%s'", filename)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "cannot stat file '%s'", filename)
+	}
+
+	off := info.Size()
+	if d.SizeBytes > 0 && off >= d.SizeBytes {
+		return checkDumpSize(f, d)
+	}
+
+	req, err := http.NewRequest("GET", d.S3URL, nil)
+	if err != nil {
+		return errors.Wrapf(err, "cannot make request for Dump of '%s' endpoint", d.Endpoint)
+	}
+	if off > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(off, 10)+"-")
+	}
+
+	resp, err := ds.client.http.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http client cannot send request")
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusRequestedRangeNotSatisfiable:
+		return checkDumpSize(f, d)
+	default:
+		if err = checkResponse(resp); err != nil {
+			return errors.Wrapf(err, "cannot download Dump of '%s' endpoint", d.Endpoint)
+		}
+		off = 0
+	}
+
+	if err = f.Truncate(off); err != nil {
+		return errors.Wrapf(err, "cannot truncate file '%s'", filename)
+	}
+
+	if _, err = f.Seek(off, io.SeekStart); err != nil {
+		return errors.Wrapf(err, "cannot seek file '%s'", filename)
+	}
+
+	if _, err = io.Copy(f, resp.Body); err != nil {
+		return errors.Wrapf(err, "cannot download Dump of '%s' endpoint", d.Endpoint)
+	}
+
+	return checkDumpSize(f, d)
+}
+
+// checkDumpSize returns an error if the size of the provided file differs
+// from the size of the provided Dump. Dumps of unknown size are not checked.
+func checkDumpSize(f *os.File, d *Dump) error {
+	if d.SizeBytes <= 0 {
+		return nil
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "cannot stat file '%s'", f.Name())
+	}
+
+	if info.Size() != d.SizeBytes {
+		return errors.Wrapf(ErrDumpSize, "file '%s' has %d bytes instead of %d", f.Name(), info.Size(), d.SizeBytes)
+	}
+
+	return nil
+}
+
+// DumpReader reads the rows of a CSV data dump one at a time and decodes
+// them into the struct used to represent the objects of the dump's endpoint,
+// such as Game or Company. Columns are matched to struct fields by their JSON
+// tags. Array columns, such as {1,2,3}, are decoded into slice fields, and
+// columns without a corresponding field are kept as strings in the Extras of
+// the struct.
+type DumpReader struct {
+	csv     *csv.Reader
+	columns []string
+}
+
+// NewDumpReader returns a new DumpReader that reads the CSV data dump from
+// the provided reader. The first row of the dump must name its columns.
+func NewDumpReader(r io.Reader) (*DumpReader, error) {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read columns of Dump")
+	}
+
+	columns := make([]string, len(header))
+	copy(columns, header)
+
+	return &DumpReader{csv: cr, columns: columns}, nil
+}
+
+// Columns returns the names of the columns of the data dump.
+func (dr *DumpReader) Columns() []string {
+	return dr.columns
+}
+
+// Read decodes the next row of the data dump into the struct pointed to by
+// obj. Empty columns leave their fields unset. When there are no more rows,
+// io.EOF is returned.
+func (dr *DumpReader) Read(obj interface{}) error {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrInvalidDumpTarget
+	}
+
+	row, err := dr.csv.Read()
+	if err == io.EOF {
+		return io.EOF
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot read row of Dump")
+	}
+
+	known := knownFields(v.Elem().Type())
+	raw := make(map[string]json.RawMessage, len(row))
+	for i, cell := range row {
+		if i >= len(dr.columns) || cell == "" {
+			continue
+		}
+
+		col := dr.columns[i]
+		val, err := dumpValue(known[strings.ToLower(col)].typ, cell)
+		if err != nil {
+			return errors.Wrapf(err, "cannot decode column '%s' of Dump", col)
+		}
+		raw[col] = val
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return errors.Wrap(err, "cannot encode row of Dump")
+	}
+
+	if err = json.Unmarshal(b, obj); err != nil {
+		return errors.Wrap(err, "cannot decode row of Dump")
+	}
+
+	return nil
+}
+
+// ParseDump reads every row of the CSV data dump from the provided reader and
+// appends them to the slice pointed to by result, which must be a slice of
+// pointers to the struct used to represent the objects of the dump's
+// endpoint, such as *[]*Game.
+func ParseDump(r io.Reader, result interface{}) error {
+	v := reflect.ValueOf(result)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return ErrInvalidDumpTarget
+	}
+
+	elem := v.Elem().Type().Elem()
+	if elem.Kind() != reflect.Ptr || elem.Elem().Kind() != reflect.Struct {
+		return ErrInvalidDumpTarget
+	}
+
+	dr, err := NewDumpReader(r)
+	if err != nil {
+		return err
+	}
+
+	all := v.Elem()
+	for {
+		obj := reflect.New(elem.Elem())
+		err := dr.Read(obj.Interface())
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		all.Set(reflect.Append(all, obj))
+	}
+}
+
+// dumpTimeLayouts are the layouts a time column of a data dump
+// may use instead of the number of seconds since the Unix epoch.
+var dumpTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05Z07", "2006-01-02 15:04:05", "2006-01-02"}
+
+// dumpValue converts the provided cell of a data dump into the JSON value
+// expected by a field of the provided type. If the type is nil, the cell is
+// converted into a JSON string.
+func dumpValue(t reflect.Type, cell string) (json.RawMessage, error) {
+	if t == nil {
+		return json.Marshal(cell)
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if _, err := strconv.ParseInt(cell, 10, 64); err == nil {
+			return json.RawMessage(cell), nil
+		}
+		if t == reflect.TypeOf(Timestamp(0)) {
+			for _, layout := range dumpTimeLayouts {
+				if tm, err := time.Parse(layout, cell); err == nil {
+					return json.RawMessage(strconv.FormatInt(tm.Unix(), 10)), nil
+				}
+			}
+		}
+		return nil, errors.Errorf("cannot convert '%s' to an integer", cell)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot convert '%s' to a number", cell)
+		}
+		return json.Marshal(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot convert '%s' to a boolean", cell)
+		}
+		return json.Marshal(b)
+	case reflect.String:
+		return json.Marshal(cell)
+	case reflect.Slice:
+		elems, err := parseDumpArray(cell)
+		if err != nil {
+			return nil, err
+		}
+
+		vals := make([]json.RawMessage, len(elems))
+		for i, e := range elems {
+			if vals[i], err = dumpValue(t.Elem(), e); err != nil {
+				return nil, err
+			}
+		}
+		return json.Marshal(vals)
+	default:
+		if json.Valid([]byte(cell)) {
+			return json.RawMessage(cell), nil
+		}
+		return json.Marshal(cell)
+	}
+}
+
+// parseDumpArray splits the provided array column of a data dump, such as
+// {1,2,3} or {"a, b",c}, into its elements. Elements may be enclosed in
+// double quotes, within which a backslash escapes the next character.
+func parseDumpArray(cell string) ([]string, error) {
+	if len(cell) < 2 || cell[0] != '{' || cell[len(cell)-1] != '}' {
+		return nil, errors.Wrapf(ErrInvalidDumpArray, "cannot parse '%s'", cell)
+	}
+
+	body := cell[1 : len(cell)-1]
+	if body == "" {
+		return []string{}, nil
+	}
+
+	var elems []string
+	var cur strings.Builder
+	quoted, escaped := false, false
+	for _, r := range body {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case !quoted && r == ',':
+			elems = append(elems, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	elems = append(elems, cur.String())
+
+	return elems, nil
+}
@@ -0,0 +1,331 @@
+package igdb
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const testDumpFile = "test_data/game_dump.csv"
+
+func TestDumpService_List(t *testing.T) {
+	ts, c := testServerRoutes(map[string]string{
+		"/dumps/": `[{"endpoint": "games", "file_name": "1732579200_games.csv", "updated_at": 1732579200}]`,
+	})
+	defer ts.Close()
+
+	dumps, err := c.Dumps.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dumps) != 1 || dumps[0].Endpoint != "games" || dumps[0].UpdatedAt != 1732579200 {
+		t.Errorf("got: <%v>, want: <games dump>", dumps)
+	}
+}
+
+func TestDumpService_Get(t *testing.T) {
+	ts, c := testServerRoutes(map[string]string{
+		"/dumps/games": `{"s3_url": "https://example.com/games.csv", "endpoint": "games", "file_name": "1732579200_games.csv", "size_bytes": 512, "updated_at": 1732579200, "schema_version": "1", "schema": {"id": "LONG", "genres": "LONG[]"}}`,
+	})
+	defer ts.Close()
+
+	d, err := c.Dumps.Get(EndpointGame)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.S3URL != "https://example.com/games.csv" || d.SizeBytes != 512 || d.Schema["genres"] != "LONG[]" {
+		t.Errorf("got: <%v>, want: <games dump with signed URL>", d)
+	}
+}
+
+func TestDumpServiceErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		end     endpoint
+		wantErr error
+	}{
+		{"Unknown endpoint", http.StatusOK, "[]", endpoint("foos/"), ErrUnknownEndpoint},
+		{"No results", http.StatusOK, "[]", EndpointGame, ErrNoResults},
+		{"Empty response", http.StatusOK, "", EndpointGame, errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", EndpointGame, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			d, err := c.Dumps.Get(test.end)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if d != nil {
+				t.Errorf("got: <%v>, want: <nil>", d)
+			}
+
+			if test.end != EndpointGame {
+				return
+			}
+
+			dumps, err := c.Dumps.List()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if dumps != nil {
+				t.Errorf("got: <%v>, want: <nil>", dumps)
+			}
+		})
+	}
+}
+
+func TestDumpService_Download(t *testing.T) {
+	want, err := ioutil.ReadFile(testDumpFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ranges []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		http.ServeContent(w, r, "games.csv", time.Time{}, bytes.NewReader(want))
+	}))
+	defer ts.Close()
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	d := &Dump{Endpoint: "games", S3URL: ts.URL + "/games.csv", SizeBytes: int64(len(want))}
+
+	dir, err := ioutil.TempDir("", "igdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		name      string
+		existing  []byte
+		wantRange string
+	}{
+		{"New file", nil, ""},
+		{"Partial file", want[:40], "bytes=40-"},
+		{"Complete file", want, "none"},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ranges = nil
+			name := filepath.Join(dir, test.name)
+			if test.existing != nil {
+				if err := ioutil.WriteFile(name, test.existing, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := c.Dumps.Download(d, name); err != nil {
+				t.Fatalf("test %v: %v", i, err)
+			}
+
+			got, err := ioutil.ReadFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("got: <%s>, want: <%s>", got, want)
+			}
+
+			switch {
+			case test.wantRange == "none" && len(ranges) != 0:
+				t.Errorf("got: <%v> requests, want: <no requests>", len(ranges))
+			case test.wantRange != "none" && (len(ranges) != 1 || ranges[0] != test.wantRange):
+				t.Errorf("got: <%v>, want: <%v>", ranges, test.wantRange)
+			}
+		})
+	}
+}
+
+func TestDumpService_DownloadSize(t *testing.T) {
+	want, err := ioutil.ReadFile(testDumpFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "games.csv", time.Time{}, bytes.NewReader(want))
+	}))
+	defer ts.Close()
+
+	c := NewClient(testClientID, testToken, ts.Client())
+
+	dir, err := ioutil.TempDir("", "igdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		name     string
+		existing []byte
+		size     int64
+	}{
+		{"Oversized file", append(append([]byte{}, want...), "extra"...), int64(len(want))},
+		{"Short download", nil, int64(len(want) + 10)},
+		{"Unsatisfiable range", want, int64(len(want) + 10)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			name := filepath.Join(dir, test.name)
+			if test.existing != nil {
+				if err := ioutil.WriteFile(name, test.existing, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			d := &Dump{Endpoint: "games", S3URL: ts.URL + "/games.csv", SizeBytes: test.size}
+			if err := c.Dumps.Download(d, name); errors.Cause(err) != ErrDumpSize {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrDumpSize)
+			}
+		})
+	}
+}
+
+func TestParseDump(t *testing.T) {
+	f, err := os.Open(testDumpFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var games []*Game
+	if err = ParseDump(f, &games); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(games) != 2 {
+		t.Fatalf("got: <%v> games, want: <%v> games", len(games), 2)
+	}
+
+	g := games[0]
+	if g.ID != 1942 || g.Name != "The Witcher 3: Wild Hunt" || g.Rating != 93.5 || g.FirstReleaseDate != 1431993600 {
+		t.Errorf("got: <%v>, want: <The Witcher 3>", g)
+	}
+
+	if !reflect.DeepEqual(g.Genres, []int{12, 31}) || !reflect.DeepEqual(g.Platforms, []int{6, 48, 49}) {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", g.Genres, g.Platforms, []int{12, 31}, []int{6, 48, 49})
+	}
+
+	if g.AlternativeNames == nil || len(g.AlternativeNames) != 0 || !g.Has("alternative_names") {
+		t.Errorf("got: <%v>, want: <present empty slice>", g.AlternativeNames)
+	}
+
+	if g.UpdatedAt != 1732579200 {
+		t.Errorf("got: <%v>, want: <%v>", g.UpdatedAt, 1732579200)
+	}
+
+	var code string
+	if err = g.DecodeExtra("edition_code", &code); err != nil || code != "GOTY, Complete" {
+		t.Errorf("got: <%v, %v>, want: <%v>", code, err, "GOTY, Complete")
+	}
+
+	z := games[1]
+	if z.ID != 7346 || z.Rating != 0 || z.Has("rating") || z.Keywords != nil {
+		t.Errorf("got: <%v>, want: <Breath of the Wild without rating or keywords>", z)
+	}
+}
+
+func TestDumpReader(t *testing.T) {
+	dr, err := NewDumpReader(strings.NewReader("id,name,genres\n1,Portal,{5}\n2,Bad,{x}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(dr.Columns(), []string{"id", "name", "genres"}) {
+		t.Errorf("got: <%v>, want: <%v>", dr.Columns(), []string{"id", "name", "genres"})
+	}
+
+	if err = dr.Read(Game{}); err != ErrInvalidDumpTarget {
+		t.Errorf("got: <%v>, want: <%v>", err, ErrInvalidDumpTarget)
+	}
+
+	g := &Game{}
+	if err = dr.Read(g); err != nil || g.Name != "Portal" {
+		t.Errorf("got: <%v, %v>, want: <Portal>", g, err)
+	}
+
+	if err = dr.Read(&Game{}); err == nil {
+		t.Errorf("got: <nil>, want: <error for non-integer genre>")
+	}
+
+	if err = dr.Read(&Game{}); err != io.EOF {
+		t.Errorf("got: <%v>, want: <%v>", err, io.EOF)
+	}
+}
+
+func TestParseDumpErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		csv     string
+		result  interface{}
+		wantErr error
+	}{
+		{"Non-pointer result", "id\n1\n", []*Game{}, ErrInvalidDumpTarget},
+		{"Non-slice result", "id\n1\n", &Game{}, ErrInvalidDumpTarget},
+		{"Non-pointer elements", "id\n1\n", &[]Game{}, ErrInvalidDumpTarget},
+		{"Invalid array", "id,genres\n1,5\n", &[]*Game{}, ErrInvalidDumpArray},
+		{"Empty dump", "", &[]*Game{}, io.EOF},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ParseDump(strings.NewReader(test.csv), test.result)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseDumpArray(t *testing.T) {
+	var tests = []struct {
+		cell      string
+		wantElems []string
+		wantErr   error
+	}{
+		{"{}", []string{}, nil},
+		{"{1}", []string{"1"}, nil},
+		{"{1,2,3}", []string{"1", "2", "3"}, nil},
+		{`{"a, b",c}`, []string{"a, b", "c"}, nil},
+		{`{"say \"hi\"","back\\slash"}`, []string{`say "hi"`, `back\slash`}, nil},
+		{"1,2", nil, ErrInvalidDumpArray},
+		{"{", nil, ErrInvalidDumpArray},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cell, func(t *testing.T) {
+			elems, err := parseDumpArray(test.cell)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(elems, test.wantElems) {
+				t.Errorf("got: <%q>, want: <%q>", elems, test.wantElems)
+			}
+		})
+	}
+}
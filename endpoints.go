@@ -1,26 +1,55 @@
 package igdb
 
+import (
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrRemovedEndpoint occurs when a request is made to an
+// endpoint that the IGDB no longer serves.
+var ErrRemovedEndpoint = errors.New("endpoint is no longer served by the IGDB")
+
 type endpoint string
 
 // Public IGDB API endpoints
 const (
 	EndpointAgeRating                  endpoint = "age_ratings/"
+	EndpointAgeRatingCategory          endpoint = "age_rating_categories/"
 	EndpointAgeRatingContent           endpoint = "age_rating_content_descriptions/"
+	EndpointAgeRatingOrganization      endpoint = "age_rating_organizations/"
 	EndpointAlternativeName            endpoint = "alternative_names/"
 	EndpointArtwork                    endpoint = "artworks/"
 	EndpointCharacter                  endpoint = "characters/"
+	EndpointCharacterGender            endpoint = "character_genders/"
 	EndpointCharacterMugshot           endpoint = "character_mug_shots/"
+	EndpointCharacterSpecies           endpoint = "character_species/"
 	EndpointCollection                 endpoint = "collections/"
+	EndpointCollectionMembership       endpoint = "collection_memberships/"
+	EndpointCollectionMembershipType   endpoint = "collection_membership_types/"
+	EndpointCollectionRelation         endpoint = "collection_relations/"
+	EndpointCollectionRelationType     endpoint = "collection_relation_types/"
+	EndpointCollectionType             endpoint = "collection_types/"
 	EndpointCompany                    endpoint = "companies/"
 	EndpointCompanyLogo                endpoint = "company_logos/"
 	EndpointCompanyWebsite             endpoint = "company_websites/"
 	EndpointCover                      endpoint = "covers/"
+	EndpointDateFormat                 endpoint = "date_formats/"
+	EndpointEvent                      endpoint = "events/"
+	EndpointEventLogo                  endpoint = "event_logos/"
+	EndpointEventNetwork               endpoint = "event_networks/"
 	EndpointExternalGame               endpoint = "external_games/"
+	EndpointExternalGameSource         endpoint = "external_game_sources/"
 	EndpointFranchise                  endpoint = "franchises/"
 	EndpointGame                       endpoint = "games/"
 	EndpointGameEngine                 endpoint = "game_engines/"
 	EndpointGameEngineLogo             endpoint = "game_engine_logos/"
+	EndpointGameLocalization           endpoint = "game_localizations/"
 	EndpointGameMode                   endpoint = "game_modes/"
+	EndpointGameStatus                 endpoint = "game_statuses/"
+	EndpointGameTimeToBeat             endpoint = "game_time_to_beats/"
+	EndpointGameType                   endpoint = "game_types/"
 	EndpointGameVersion                endpoint = "game_versions/"
 	EndpointGameVersionFeature         endpoint = "game_version_features/"
 	EndpointGameVersionFeatureValue    endpoint = "game_version_feature_values/"
@@ -28,24 +57,132 @@ const (
 	EndpointGenre                      endpoint = "genres/"
 	EndpointInvolvedCompany            endpoint = "involved_companies/"
 	EndpointKeyword                    endpoint = "keywords/"
+	EndpointLanguage                   endpoint = "languages/"
+	EndpointLanguageSupport            endpoint = "language_supports/"
+	EndpointLanguageSupportType        endpoint = "language_support_types/"
 	EndpointMultiplayerMode            endpoint = "multiplayer_modes/"
+	EndpointNetworkType                endpoint = "network_types/"
 	EndpointPlatform                   endpoint = "platforms/"
 	EndpointPlatformLogo               endpoint = "platform_logos/"
+	EndpointPlatformType               endpoint = "platform_types/"
 	EndpointPlatformVersion            endpoint = "platform_versions/"
 	EndpointPlatformVersionCompany     endpoint = "platform_version_companies/"
 	EndpointPlatformVersionReleaseDate endpoint = "platform_version_release_dates/"
 	EndpointPlatformWebsite            endpoint = "platform_websites/"
 	EndpointPlayerPerspective          endpoint = "player_perspectives/"
 	EndpointPlatformFamily             endpoint = "product_families/"
-	EndpointPulse                      endpoint = "pulses/"
+	EndpointPopularityPrimitive        endpoint = "popularity_primitives/"
+	EndpointPopularityType             endpoint = "popularity_types/"
+	EndpointRegion                     endpoint = "regions/"
 	EndpointReleaseDate                endpoint = "release_dates/"
+	EndpointReleaseDateStatus          endpoint = "release_date_statuses/"
 	EndpointScreenshot                 endpoint = "screenshots/"
 	EndpointSearch                     endpoint = "search/"
 	EndpointTheme                      endpoint = "themes/"
-	EndpointTitle                      endpoint = "titles/"
 	EndpointWebsite                    endpoint = "websites/"
+	EndpointWebsiteType                endpoint = "website_types/"
 )
 
+// Removed IGDB API endpoints
+const (
+	// Deprecated: The IGDB v4 no longer serves pulses. Requests to this
+	// endpoint fail with ErrRemovedEndpoint.
+	EndpointPulse endpoint = "pulses/"
+	// Deprecated: The IGDB v4 no longer serves titles. Requests to this
+	// endpoint fail with ErrRemovedEndpoint.
+	EndpointTitle endpoint = "titles/"
+)
+
+// removedEndpoints lists the endpoints that
+// the IGDB no longer serves.
+var removedEndpoints = []endpoint{
+	EndpointPulse,
+	EndpointTitle,
+}
+
+// isRemoved returns true if the provided endpoint, or the endpoint
+// it counts or describes, is no longer served by the IGDB.
+func isRemoved(end endpoint) bool {
+	for _, r := range removedEndpoints {
+		if strings.HasPrefix(string(end), string(r)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// modelTypes maps each IGDB API endpoint to the type
+// of the objects it responds with.
+var modelTypes = map[endpoint]reflect.Type{
+	EndpointAgeRating:                  reflect.TypeOf(AgeRating{}),
+	EndpointAgeRatingCategory:          reflect.TypeOf(AgeRatingCategoryLookup{}),
+	EndpointAgeRatingContent:           reflect.TypeOf(AgeRatingContent{}),
+	EndpointAgeRatingOrganization:      reflect.TypeOf(AgeRatingOrganizationLookup{}),
+	EndpointAlternativeName:            reflect.TypeOf(AlternativeName{}),
+	EndpointArtwork:                    reflect.TypeOf(Artwork{}),
+	EndpointCharacter:                  reflect.TypeOf(Character{}),
+	EndpointCharacterGender:            reflect.TypeOf(CharacterGenderLookup{}),
+	EndpointCharacterMugshot:           reflect.TypeOf(CharacterMugshot{}),
+	EndpointCharacterSpecies:           reflect.TypeOf(CharacterSpeciesLookup{}),
+	EndpointCollection:                 reflect.TypeOf(Collection{}),
+	EndpointCollectionMembership:       reflect.TypeOf(CollectionMembership{}),
+	EndpointCollectionMembershipType:   reflect.TypeOf(CollectionMembershipType{}),
+	EndpointCollectionRelation:         reflect.TypeOf(CollectionRelation{}),
+	EndpointCollectionRelationType:     reflect.TypeOf(CollectionRelationType{}),
+	EndpointCollectionType:             reflect.TypeOf(CollectionType{}),
+	EndpointCompany:                    reflect.TypeOf(Company{}),
+	EndpointCompanyLogo:                reflect.TypeOf(CompanyLogo{}),
+	EndpointCompanyWebsite:             reflect.TypeOf(CompanyWebsite{}),
+	EndpointCover:                      reflect.TypeOf(Cover{}),
+	EndpointDateFormat:                 reflect.TypeOf(DateFormatLookup{}),
+	EndpointEvent:                      reflect.TypeOf(Event{}),
+	EndpointEventLogo:                  reflect.TypeOf(EventLogo{}),
+	EndpointEventNetwork:               reflect.TypeOf(EventNetwork{}),
+	EndpointExternalGame:               reflect.TypeOf(ExternalGame{}),
+	EndpointExternalGameSource:         reflect.TypeOf(ExternalGameSourceLookup{}),
+	EndpointFranchise:                  reflect.TypeOf(Franchise{}),
+	EndpointGame:                       reflect.TypeOf(Game{}),
+	EndpointGameEngine:                 reflect.TypeOf(GameEngine{}),
+	EndpointGameEngineLogo:             reflect.TypeOf(GameEngineLogo{}),
+	EndpointGameLocalization:           reflect.TypeOf(GameLocalization{}),
+	EndpointGameMode:                   reflect.TypeOf(GameMode{}),
+	EndpointGameStatus:                 reflect.TypeOf(GameStatusLookup{}),
+	EndpointGameTimeToBeat:             reflect.TypeOf(GameTimeToBeat{}),
+	EndpointGameType:                   reflect.TypeOf(GameTypeLookup{}),
+	EndpointGameVersion:                reflect.TypeOf(GameVersion{}),
+	EndpointGameVersionFeature:         reflect.TypeOf(GameVersionFeature{}),
+	EndpointGameVersionFeatureValue:    reflect.TypeOf(GameVersionFeatureValue{}),
+	EndpointGameVideo:                  reflect.TypeOf(GameVideo{}),
+	EndpointGenre:                      reflect.TypeOf(Genre{}),
+	EndpointInvolvedCompany:            reflect.TypeOf(InvolvedCompany{}),
+	EndpointKeyword:                    reflect.TypeOf(Keyword{}),
+	EndpointLanguage:                   reflect.TypeOf(Language{}),
+	EndpointLanguageSupport:            reflect.TypeOf(LanguageSupport{}),
+	EndpointLanguageSupportType:        reflect.TypeOf(LanguageSupportType{}),
+	EndpointMultiplayerMode:            reflect.TypeOf(MultiplayerMode{}),
+	EndpointNetworkType:                reflect.TypeOf(NetworkType{}),
+	EndpointPlatform:                   reflect.TypeOf(Platform{}),
+	EndpointPlatformLogo:               reflect.TypeOf(PlatformLogo{}),
+	EndpointPlatformType:               reflect.TypeOf(PlatformTypeLookup{}),
+	EndpointPlatformVersion:            reflect.TypeOf(PlatformVersion{}),
+	EndpointPlatformVersionCompany:     reflect.TypeOf(PlatformVersionCompany{}),
+	EndpointPlatformVersionReleaseDate: reflect.TypeOf(PlatformVersionReleaseDate{}),
+	EndpointPlatformWebsite:            reflect.TypeOf(PlatformWebsite{}),
+	EndpointPlayerPerspective:          reflect.TypeOf(PlayerPerspective{}),
+	EndpointPlatformFamily:             reflect.TypeOf(PlatformFamily{}),
+	EndpointPopularityPrimitive:        reflect.TypeOf(PopularityPrimitive{}),
+	EndpointPopularityType:             reflect.TypeOf(PopularityType{}),
+	EndpointRegion:                     reflect.TypeOf(Region{}),
+	EndpointReleaseDate:                reflect.TypeOf(ReleaseDate{}),
+	EndpointReleaseDateStatus:          reflect.TypeOf(ReleaseDateStatusLookup{}),
+	EndpointScreenshot:                 reflect.TypeOf(Screenshot{}),
+	EndpointSearch:                     reflect.TypeOf(SearchResult{}),
+	EndpointTheme:                      reflect.TypeOf(Theme{}),
+	EndpointWebsite:                    reflect.TypeOf(Website{}),
+	EndpointWebsiteType:                reflect.TypeOf(WebsiteTypeLookup{}),
+}
+
 // Count contains the number of objects
 // of a certain type counted in the IGDB.
 type Count struct {
@@ -71,3 +71,24 @@ func TestClient_GetCount(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRemoved(t *testing.T) {
+	var tests = []struct {
+		name        string
+		end         endpoint
+		wantRemoved bool
+	}{
+		{"Served endpoint", EndpointGame, false},
+		{"Served meta endpoint", EndpointGame + suffixMeta, false},
+		{"Removed endpoint", EndpointPulse, true},
+		{"Removed count endpoint", EndpointTitle + suffixCount, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if r := isRemoved(test.end); r != test.wantRemoved {
+				t.Errorf("got: <%v>, want: <%v>", r, test.wantRemoved)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct Event -add-tags json -w
+
+// Event represents a gaming event such as a showcase, conference, or
+// stream along with the games and videos featured in it.
+// For more information visit: https://api-docs.igdb.com/#event
+type Event struct {
+	Presence
+	Extras
+	ID            int       `json:"id"`
+	Checksum      string    `json:"checksum"`
+	CreatedAt     Timestamp `json:"created_at"`
+	Description   string    `json:"description"`
+	EndTime       Timestamp `json:"end_time"`
+	EventLogo     int       `json:"event_logo"`
+	EventNetworks []int     `json:"event_networks"`
+	Games         []int     `json:"games"`
+	LiveStreamURL string    `json:"live_stream_url"`
+	Name          string    `json:"name"`
+	Slug          string    `json:"slug"`
+	StartTime     Timestamp `json:"start_time"`
+	TimeZone      string    `json:"time_zone"`
+	UpdatedAt     Timestamp `json:"updated_at"`
+	Videos        []int     `json:"videos"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the Event and
+// records which of its fields were present or unrecognized.
+func (e *Event) UnmarshalJSON(b []byte) error {
+	type event Event
+	return decodeModel(b, (*event)(e), &e.Presence, &e.Extras)
+}
+
+// EventService handles all the API calls for the IGDB Event endpoint.
+type EventService service
+
+// Get returns a single Event identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any Events, an error is returned.
+func (es *EventService) Get(id int, opts ...Option) (*Event, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var ev []*Event
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := es.client.post(es.end, &ev, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Event with ID %v", id)
+	}
+
+	return ev[0], nil
+}
+
+// GetBySlug returns a single Event identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Events, an error is returned.
+func (es *EventService) GetBySlug(slug string, opts ...Option) (*Event, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var ev []*Event
+
+	opts = append(opts, setSlug(slug))
+	err := es.client.post(es.end, &ev, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Event with slug %s", slug)
+	}
+
+	return ev[0], nil
+}
+
+// List returns a list of Events identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a Event is ignored. If none of the IDs
+// match a Event, an error is returned.
+func (es *EventService) List(ids []int, opts ...Option) ([]*Event, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var ev []*Event
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := es.client.post(es.end, &ev, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Events with IDs %v", ids)
+	}
+
+	return ev, nil
+}
+
+// Index returns an index of Events based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no Events can
+// be found using the provided options, an error is returned.
+func (es *EventService) Index(opts ...Option) ([]*Event, error) {
+	var ev []*Event
+
+	err := es.client.post(es.end, &ev, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of Events")
+	}
+
+	return ev, nil
+}
+
+// Search returns a list of Events found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no Events are found using the provided query, an error is returned.
+func (es *EventService) Search(qry string, opts ...Option) ([]*Event, error) {
+	var ev []*Event
+
+	opts = append(opts, setSearch(qry))
+	err := es.client.post(es.end, &ev, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Event with query %s", qry)
+	}
+
+	return ev, nil
+}
+
+// Count returns the number of Events available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which Events to count.
+func (es *EventService) Count(opts ...Option) (int, error) {
+	ct, err := es.client.getCount(es.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count Events")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB Event object.
+func (es *EventService) Fields() ([]string, error) {
+	f, err := es.client.getFields(es.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get Event fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,307 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	testEventGet    string = "test_data/event_get.json"
+	testEventList   string = "test_data/event_list.json"
+	testEventSearch string = "test_data/event_search.json"
+)
+
+func TestEventService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testEventGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Event, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		id        int
+		opts      []Option
+		wantEvent *Event
+		wantErr   error
+	}{
+		{"Valid response", testEventGet, 1, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ev, err := c.Events.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ev, test.wantEvent) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ev, test.wantEvent)
+			}
+		})
+	}
+}
+
+func TestEventService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testEventGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Event, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		slug      string
+		opts      []Option
+		wantEvent *Event
+		wantErr   error
+	}{
+		{"Valid response", testEventGet, "summer-game-fest-2022", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "summer-game-fest-2022", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "summer-game-fest-2022", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ev, err := c.Events.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ev, test.wantEvent) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ev, test.wantEvent)
+			}
+		})
+	}
+}
+
+func TestEventService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testEventList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Event, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name       string
+		file       string
+		ids        []int
+		opts       []Option
+		wantEvents []*Event
+		wantErr    error
+	}{
+		{"Valid response", testEventList, []int{1, 2, 3, 4, 5}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{1, 2, 3, 4, 5}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{1, 2, 3, 4, 5}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ev, err := c.Events.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ev, test.wantEvents) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ev, test.wantEvents)
+			}
+		})
+	}
+}
+
+func TestEventService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testEventList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Event, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		file       string
+		opts       []Option
+		wantEvents []*Event
+		wantErr    error
+	}{
+		{"Valid response", testEventList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ev, err := c.Events.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ev, test.wantEvents) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ev, test.wantEvents)
+			}
+		})
+	}
+}
+
+func TestEventService_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(testEventSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Event, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name       string
+		file       string
+		qry        string
+		opts       []Option
+		wantEvents []*Event
+		wantErr    error
+	}{
+		{"Valid response", testEventSearch, "summer game fest", []Option{SetLimit(50)}, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "summer game fest", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "summer game fest", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ev, err := c.Events.Search(test.qry, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ev, test.wantEvents) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ev, test.wantEvents)
+			}
+		})
+	}
+}
+
+func TestEventService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.Events.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestEventService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.Events.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+// EventLogo represents the logo of a particular event.
+// For more information visit: https://api-docs.igdb.com/#event-logo
+type EventLogo struct {
+	Image
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Event     int       `json:"event"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the EventLogo and
+// records which of its fields were present or unrecognized.
+func (e *EventLogo) UnmarshalJSON(b []byte) error {
+	type eventLogo EventLogo
+	return decodeModel(b, (*eventLogo)(e), &e.Presence, &e.Extras)
+}
+
+// EventLogoService handles all the API calls for the IGDB EventLogo endpoint.
+type EventLogoService service
+
+// Get returns a single EventLogo identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any EventLogos, an error is returned.
+func (es *EventLogoService) Get(id int, opts ...Option) (*EventLogo, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var logo []*EventLogo
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := es.client.post(es.end, &logo, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get EventLogo with ID %v", id)
+	}
+
+	return logo[0], nil
+}
+
+// List returns a list of EventLogos identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a EventLogo is ignored. If none of the IDs
+// match a EventLogo, an error is returned.
+func (es *EventLogoService) List(ids []int, opts ...Option) ([]*EventLogo, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var logo []*EventLogo
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := es.client.post(es.end, &logo, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get EventLogos with IDs %v", ids)
+	}
+
+	return logo, nil
+}
+
+// Index returns an index of EventLogos based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no EventLogos can
+// be found using the provided options, an error is returned.
+func (es *EventLogoService) Index(opts ...Option) ([]*EventLogo, error) {
+	var logo []*EventLogo
+
+	err := es.client.post(es.end, &logo, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of EventLogos")
+	}
+
+	return logo, nil
+}
+
+// Count returns the number of EventLogos available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which EventLogos to count.
+func (es *EventLogoService) Count(opts ...Option) (int, error) {
+	ct, err := es.client.getCount(es.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count EventLogos")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB EventLogo object.
+func (es *EventLogoService) Fields() ([]string, error) {
+	f, err := es.client.getFields(es.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get EventLogo fields")
+	}
+
+	return f, nil
+}
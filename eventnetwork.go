@@ -0,0 +1,116 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct EventNetwork -add-tags json -w
+
+// EventNetwork represents a URL at which a particular event can be found
+// such as a stream or social media page.
+// For more information visit: https://api-docs.igdb.com/#event-network
+type EventNetwork struct {
+	Presence
+	Extras
+	ID          int       `json:"id"`
+	Checksum    string    `json:"checksum"`
+	CreatedAt   Timestamp `json:"created_at"`
+	Event       int       `json:"event"`
+	NetworkType int       `json:"network_type"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+	URL         string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the EventNetwork and
+// records which of its fields were present or unrecognized.
+func (e *EventNetwork) UnmarshalJSON(b []byte) error {
+	type eventNetwork EventNetwork
+	return decodeModel(b, (*eventNetwork)(e), &e.Presence, &e.Extras)
+}
+
+// EventNetworkService handles all the API calls for the IGDB EventNetwork endpoint.
+type EventNetworkService service
+
+// Get returns a single EventNetwork identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any EventNetworks, an error is returned.
+func (es *EventNetworkService) Get(id int, opts ...Option) (*EventNetwork, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var en []*EventNetwork
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := es.client.post(es.end, &en, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get EventNetwork with ID %v", id)
+	}
+
+	return en[0], nil
+}
+
+// List returns a list of EventNetworks identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a EventNetwork is ignored. If none of the IDs
+// match a EventNetwork, an error is returned.
+func (es *EventNetworkService) List(ids []int, opts ...Option) ([]*EventNetwork, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var en []*EventNetwork
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := es.client.post(es.end, &en, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get EventNetworks with IDs %v", ids)
+	}
+
+	return en, nil
+}
+
+// Index returns an index of EventNetworks based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no EventNetworks can
+// be found using the provided options, an error is returned.
+func (es *EventNetworkService) Index(opts ...Option) ([]*EventNetwork, error) {
+	var en []*EventNetwork
+
+	err := es.client.post(es.end, &en, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of EventNetworks")
+	}
+
+	return en, nil
+}
+
+// Count returns the number of EventNetworks available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which EventNetworks to count.
+func (es *EventNetworkService) Count(opts ...Option) (int, error) {
+	ct, err := es.client.getCount(es.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count EventNetworks")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB EventNetwork object.
+func (es *EventNetworkService) Fields() ([]string, error) {
+	f, err := es.client.getFields(es.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get EventNetwork fields")
+	}
+
+	return f, nil
+}
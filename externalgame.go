@@ -13,18 +13,32 @@ import (
 // on a third party service.
 // For more information visit: https://api-docs.igdb.com/#external-game
 type ExternalGame struct {
-	ID        int                  `json:"id"`
-	Category  ExternalGameCategory `json:"category"`
-	CreatedAt int                  `json:"created_at"`
-	Game      int                  `json:"game"`
-	Name      string               `json:"name"`
-	UID       string               `json:"uid"`
-	UpdatedAt int                  `json:"updated_at"`
-	Url       string               `json:"url"`
-	Year      int                  `json:"year"`
+	Presence
+	Extras
+	ID                 int                  `json:"id"`
+	Category           ExternalGameCategory `json:"category"`
+	CreatedAt          Timestamp            `json:"created_at"`
+	ExternalGameSource int                  `json:"external_game_source"`
+	Game               int                  `json:"game"`
+	Name               string               `json:"name"`
+	UID                string               `json:"uid"`
+	UpdatedAt          Timestamp            `json:"updated_at"`
+	Url                string               `json:"url"`
+	Year               int                  `json:"year"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the ExternalGame and
+// records which of its fields were present or unrecognized.
+func (e *ExternalGame) UnmarshalJSON(b []byte) error {
+	type externalGame ExternalGame
+	return decodeModel(b, (*externalGame)(e), &e.Presence, &e.Extras)
 }
 
 // ExternalGameCategory speficies an external game, platform, or media service.
+//
+// The IGDB v4 deprecates the category field of an ExternalGame in favor of
+// the external_game_source field, which holds an External Game Source ID
+// instead.
 type ExternalGameCategory int
 
 //go:generate stringer -type=ExternalGameCategory
@@ -46,6 +60,35 @@ const (
 	ExternalApple
 	ExternalTwitch
 	ExternalAndroid
+	_
+	_
+	_
+	_
+	ExternalAmazonASIN
+	_
+	ExternalAmazonLuna
+	ExternalAmazonADG
+	_
+	_
+	ExternalEpicGameStore
+	_
+	ExternalOculus
+	ExternalUtomik
+	ExternalItchIO
+	ExternalXboxMarketplace
+	ExternalKartridge
+	_
+	_
+	_
+	ExternalPlaystationStoreUS
+	ExternalFocusEntertainment
+)
+
+// Expected ExternalGameCategory enums from the IGDB that
+// are numbered well apart from the others.
+const (
+	ExternalXboxGamePassUltimateCloud ExternalGameCategory = 54
+	ExternalGamejolt                  ExternalGameCategory = 55
 )
 
 // ExternalGameService handles all the API calls for the IGDB ExternalGame endpoint.
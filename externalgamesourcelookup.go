@@ -0,0 +1,115 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct ExternalGameSourceLookup -add-tags json -w
+
+// ExternalGameSourceLookup represents a third party service that lists games
+// such as Steam or GOG. It replaces the ExternalGameCategory enums in the
+// IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#external-game-source
+type ExternalGameSourceLookup struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the ExternalGameSourceLookup and
+// records which of its fields were present or unrecognized.
+func (e *ExternalGameSourceLookup) UnmarshalJSON(b []byte) error {
+	type externalGameSourceLookup ExternalGameSourceLookup
+	return decodeModel(b, (*externalGameSourceLookup)(e), &e.Presence, &e.Extras)
+}
+
+// ExternalGameSourceLookupService handles all the API calls for the IGDB ExternalGameSourceLookup endpoint.
+type ExternalGameSourceLookupService service
+
+// Get returns a single ExternalGameSourceLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any ExternalGameSourceLookups, an error is returned.
+func (es *ExternalGameSourceLookupService) Get(id int, opts ...Option) (*ExternalGameSourceLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var src []*ExternalGameSourceLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := es.client.post(es.end, &src, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get ExternalGameSourceLookup with ID %v", id)
+	}
+
+	return src[0], nil
+}
+
+// List returns a list of ExternalGameSourceLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a ExternalGameSourceLookup is ignored. If none of the IDs
+// match a ExternalGameSourceLookup, an error is returned.
+func (es *ExternalGameSourceLookupService) List(ids []int, opts ...Option) ([]*ExternalGameSourceLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var src []*ExternalGameSourceLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := es.client.post(es.end, &src, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get ExternalGameSourceLookups with IDs %v", ids)
+	}
+
+	return src, nil
+}
+
+// Index returns an index of ExternalGameSourceLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no ExternalGameSourceLookups can
+// be found using the provided options, an error is returned.
+func (es *ExternalGameSourceLookupService) Index(opts ...Option) ([]*ExternalGameSourceLookup, error) {
+	var src []*ExternalGameSourceLookup
+
+	err := es.client.post(es.end, &src, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of ExternalGameSourceLookups")
+	}
+
+	return src, nil
+}
+
+// Count returns the number of ExternalGameSourceLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which ExternalGameSourceLookups to count.
+func (es *ExternalGameSourceLookupService) Count(opts ...Option) (int, error) {
+	ct, err := es.client.getCount(es.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count ExternalGameSourceLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB ExternalGameSourceLookup object.
+func (es *ExternalGameSourceLookupService) Fields() ([]string, error) {
+	f, err := es.client.getFields(es.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get ExternalGameSourceLookup fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,93 @@
+package igdb
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownField occurs when an unrecognized field is requested from an
+// object that did not receive that field in its response.
+var ErrUnknownField = errors.New("unrecognized field not present in response")
+
+// Extras retains the fields of an IGDB object that were present in the API
+// response it was decoded from but are not yet represented by the object's
+// struct. Extras is embedded in every IGDB object so that fields recently
+// added to the IGDB API can be read before this package is updated.
+//
+// The fields are held by pointer so that the IGDB objects embedding
+// Extras can still be compared with the == operator.
+type Extras struct {
+	extra *map[string]json.RawMessage
+}
+
+// fields returns the unrecognized fields of the Extras.
+func (e Extras) fields() map[string]json.RawMessage {
+	if e.extra == nil {
+		return nil
+	}
+
+	return *e.extra
+}
+
+// Extra returns the raw JSON value of the unrecognized field with the
+// provided JSON name and true if that field was present in the response.
+// Otherwise, nil and false are returned.
+func (e Extras) Extra(field string) (json.RawMessage, bool) {
+	raw, ok := e.fields()[field]
+	return raw, ok
+}
+
+// ExtraNames returns the JSON names of every unrecognized field that was
+// present in the response, in ascending order.
+func (e Extras) ExtraNames() []string {
+	f := make([]string, 0, len(e.fields()))
+	for k := range e.fields() {
+		f = append(f, k)
+	}
+	sort.Strings(f)
+
+	return f
+}
+
+// DecodeExtra decodes the raw JSON value of the unrecognized field with the
+// provided JSON name into the value pointed to by v. If the field was not
+// present in the response, ErrUnknownField is returned.
+func (e Extras) DecodeExtra(field string, v interface{}) error {
+	raw, ok := e.fields()[field]
+	if !ok {
+		return errors.Wrapf(ErrUnknownField, "cannot decode field '%s'", field)
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return errors.Wrapf(err, "cannot decode field '%s'", field)
+	}
+
+	return nil
+}
+
+// knownFieldCache maps a struct type to its fields
+// keyed by the JSON names they are decoded from.
+var knownFieldCache sync.Map
+
+// knownFields returns every field the provided struct type decodes, including
+// the fields of embedded structs, keyed by their lowercase JSON names. Names
+// are lowercase because JSON object keys are matched to struct fields without
+// regard to case.
+func knownFields(t reflect.Type) map[string]modelField {
+	if known, ok := knownFieldCache.Load(t); ok {
+		return known.(map[string]modelField)
+	}
+
+	known := make(map[string]modelField)
+	for _, f := range modelFields(t) {
+		known[strings.ToLower(f.tag)] = f
+	}
+
+	knownFieldCache.Store(t, known)
+	return known
+}
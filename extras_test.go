@@ -0,0 +1,103 @@
+package igdb
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestExtras_Extra(t *testing.T) {
+	var tests = []struct {
+		name      string
+		json      string
+		wantNames []string
+		wantExtra map[string]string
+	}{
+		{"Zero fields", `{}`, []string{}, nil},
+		{"Known fields only", `{"id": 1, "name": "Zelda"}`, []string{}, nil},
+		{"Unknown fields", `{"id": 1, "edition_code": "abc", "popularity_rank": 0}`, []string{"edition_code", "popularity_rank"}, map[string]string{"edition_code": `"abc"`, "popularity_rank": "0"}},
+		{"Unknown null field", `{"id": 1, "popularity_rank": null}`, []string{"popularity_rank"}, map[string]string{"popularity_rank": "null"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var g Game
+			if err := json.Unmarshal([]byte(test.json), &g); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(g.ExtraNames(), test.wantNames) {
+				t.Errorf("got: <%v>, want: <%v>", g.ExtraNames(), test.wantNames)
+			}
+
+			for k, want := range test.wantExtra {
+				raw, ok := g.Extra(k)
+				if !ok {
+					t.Fatalf("got: <missing %s>, want: <%s>", k, want)
+				}
+
+				if string(raw) != want {
+					t.Errorf("got: <%s>, want: <%s>", raw, want)
+				}
+			}
+
+			if _, ok := g.Extra("name"); ok {
+				t.Errorf("got: <name>, want: <known fields excluded>")
+			}
+		})
+	}
+}
+
+func TestExtras_DecodeExtra(t *testing.T) {
+	var g Game
+	err := json.Unmarshal([]byte(`{"id": 1, "editions": [5, 6], "edition_code": "abc"}`), &g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var col []int
+	if err = g.DecodeExtra("editions", &col); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(col, []int{5, 6}) {
+		t.Errorf("got: <%v>, want: <%v>", col, []int{5, 6})
+	}
+
+	var sum int
+	if err = g.DecodeExtra("edition_code", &sum); err == nil {
+		t.Errorf("got: <nil>, want: <type error>")
+	}
+
+	if err = g.DecodeExtra("missing", &sum); errors.Cause(err) != ErrUnknownField {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrUnknownField)
+	}
+}
+
+func TestExtras_CaseInsensitive(t *testing.T) {
+	var ch Character
+	if err := json.Unmarshal([]byte(`{"id": 10, "name": "Link"}`), &ch); err != nil {
+		t.Fatal(err)
+	}
+
+	if ch.ID != 10 {
+		t.Errorf("got: <%v>, want: <%v>", ch.ID, 10)
+	}
+
+	if len(ch.ExtraNames()) != 0 {
+		t.Errorf("got: <%v>, want: <%v>", ch.ExtraNames(), []string{})
+	}
+}
+
+func TestExtras_Embedded(t *testing.T) {
+	var cov Cover
+	if err := json.Unmarshal([]byte(`{"id": 1, "image_id": "abc", "width": 10, "checksum": "xyz"}`), &cov); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(cov.ExtraNames(), []string{"checksum"}) {
+		t.Errorf("got: <%v>, want: <%v>", cov.ExtraNames(), []string{"checksum"})
+	}
+}
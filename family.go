@@ -0,0 +1,281 @@
+package igdb
+
+import (
+	"sort"
+
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+)
+
+// FamilyRelation specifies how a Game in a Family relates to another.
+type FamilyRelation string
+
+// Expected FamilyRelations between the Games of a Family. Each describes
+// what the Game at the end of a FamilyEdge is to the Game at its start.
+const (
+	FamilyDLC                 FamilyRelation = "dlc"
+	FamilyExpansion           FamilyRelation = "expansion"
+	FamilyStandaloneExpansion FamilyRelation = "standalone_expansion"
+	FamilyVersion             FamilyRelation = "version"
+	FamilyBundle              FamilyRelation = "bundle"
+	FamilyChild               FamilyRelation = "child"
+)
+
+// FamilyEdge connects two Games of a Family by their IDs. The Relation
+// describes what the To Game is to the From Game. For example, an edge
+// with the FamilyDLC relation points from a base game to one of its DLCs,
+// and an edge with the FamilyBundle relation points from a game to a
+// bundle that contains it.
+type FamilyEdge struct {
+	From     int            `json:"from"`
+	To       int            `json:"to"`
+	Relation FamilyRelation `json:"relation"`
+}
+
+// Family contains every Game related to a particular Game through their
+// parent games, version parents, DLCs, expansions, standalone expansions,
+// and bundles, along with the typed edges between them.
+type Family struct {
+	Root  int           `json:"root"`
+	Base  int           `json:"base"`
+	Games map[int]*Game `json:"games"`
+	Edges []FamilyEdge  `json:"edges"`
+}
+
+// Children returns the edges that start at the Game with the provided ID
+// and have one of the provided relations. If no relations are provided,
+// every edge starting at the Game is returned.
+func (f *Family) Children(id int, rels ...FamilyRelation) []FamilyEdge {
+	var edges []FamilyEdge
+	for _, e := range f.Edges {
+		if e.From == id && hasRelation(rels, e.Relation) {
+			edges = append(edges, e)
+		}
+	}
+
+	return edges
+}
+
+// Parents returns the edges that end at the Game with the provided ID and
+// have one of the provided relations. If no relations are provided, every
+// edge ending at the Game is returned.
+func (f *Family) Parents(id int, rels ...FamilyRelation) []FamilyEdge {
+	var edges []FamilyEdge
+	for _, e := range f.Edges {
+		if e.To == id && hasRelation(rels, e.Relation) {
+			edges = append(edges, e)
+		}
+	}
+
+	return edges
+}
+
+// hasRelation reports whether rel is one of the provided relations.
+// Every relation is accepted if none are provided.
+func hasRelation(rels []FamilyRelation, rel FamilyRelation) bool {
+	if len(rels) == 0 {
+		return true
+	}
+
+	for _, r := range rels {
+		if r == rel {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Family returns the Family of the Game identified by the provided IGDB ID.
+// Starting from the Game, every related Game is retrieved in turn: the Games
+// it references through its parent game, version parent, DLCs, expansions,
+// standalone expansions, and bundles, as well as the Games that name it as
+// their parent game or version parent. Each Game is only retrieved once, so
+// cyclic relationships are handled. The Base of the Family is found by
+// following the parent games and version parents of the Game to the top.
+// If the ID does not match a Game, an error is returned.
+func (gs *GameService) Family(id int) (*Family, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	f := &Family{Root: id, Games: make(map[int]*Game)}
+	seen := make(map[FamilyEdge]bool)
+	link := func(from, to int, rel FamilyRelation) {
+		e := FamilyEdge{From: from, To: to, Relation: rel}
+		if from != 0 && to != 0 && from != to && !seen[e] {
+			seen[e] = true
+			f.Edges = append(f.Edges, e)
+		}
+	}
+
+	queued := map[int]bool{id: true}
+	next := []int{id}
+	for len(next) > 0 {
+		found, err := gs.client.getByIDs(EndpointGame, next)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get Family of Game with ID %v", id)
+		}
+
+		kids, err := gs.familyChildren(next)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get Family of Game with ID %v", id)
+		}
+
+		var games []*Game
+		for _, obj := range found {
+			games = append(games, obj.Interface().(*Game))
+		}
+		for _, g := range kids {
+			if _, ok := f.Games[g.ID]; !ok && !queued[g.ID] {
+				games = append(games, g)
+			}
+		}
+
+		next = nil
+		for _, g := range games {
+			f.Games[g.ID] = g
+			queued[g.ID] = true
+
+			link(g.ParentGame, g.ID, parentRelation(gameCategory(g)))
+			link(g.VersionParent, g.ID, FamilyVersion)
+			for _, to := range g.DLCS {
+				link(g.ID, to, FamilyDLC)
+			}
+			for _, to := range g.Expansions {
+				link(g.ID, to, FamilyExpansion)
+			}
+			for _, to := range g.StandaloneExpansions {
+				link(g.ID, to, FamilyStandaloneExpansion)
+			}
+			for _, to := range g.Bundles {
+				link(g.ID, to, FamilyBundle)
+			}
+		}
+
+		for _, e := range f.Edges {
+			for _, rid := range []int{e.From, e.To} {
+				if !queued[rid] {
+					queued[rid] = true
+					next = append(next, rid)
+				}
+			}
+		}
+		sort.Ints(next)
+	}
+
+	if _, ok := f.Games[id]; !ok {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get Family of Game with ID %v", id)
+	}
+
+	f.Edges = familyEdges(f.Edges)
+	f.Base = familyBase(f, id)
+
+	return f, nil
+}
+
+// familyChildren returns every Game that names one of the provided Games
+// as its parent game or version parent.
+func (gs *GameService) familyChildren(ids []int) ([]*Game, error) {
+	var kids []*Game
+	for start := 0; start < len(ids); start += maxLimit {
+		stop := start + maxLimit
+		if stop > len(ids) {
+			stop = len(ids)
+		}
+
+		err := gs.client.postAll(gs.end, &kids,
+			SetFields("*"),
+			setFilterAny(OpContainsAtLeast, sliceconv.Itoa(ids[start:stop]), "parent_game", "version_parent"),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return kids, nil
+}
+
+// gameCategory returns the GameCategory of the provided Game. Games that
+// only carry the game_type field have their Game Type ID used instead, since
+// the IDs of the IGDB's Game Types share the values of the GameCategory
+// constants.
+func gameCategory(g *Game) GameCategory {
+	if g.Category == MainGame && g.GameType != 0 {
+		return GameCategory(g.GameType)
+	}
+
+	return g.Category
+}
+
+// parentRelation returns the FamilyRelation a Game of the provided category
+// has to its parent game.
+func parentRelation(cat GameCategory) FamilyRelation {
+	switch cat {
+	case DLCAddon:
+		return FamilyDLC
+	case Expansion:
+		return FamilyExpansion
+	case StandaloneExpansion:
+		return FamilyStandaloneExpansion
+	default:
+		return FamilyChild
+	}
+}
+
+// familyEdges removes the edges that duplicate a more specific relation
+// between the same Games and sorts the rest. A parent game link to a child is
+// dropped when the child is also listed by a more specific relation.
+func familyEdges(edges []FamilyEdge) []FamilyEdge {
+	specific := make(map[[2]int]bool)
+	for _, e := range edges {
+		if e.Relation != FamilyChild {
+			specific[[2]int{e.From, e.To}] = true
+		}
+	}
+
+	var res []FamilyEdge
+	for _, e := range edges {
+		if e.Relation == FamilyChild && specific[[2]int{e.From, e.To}] {
+			continue
+		}
+		res = append(res, e)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].From != res[j].From {
+			return res[i].From < res[j].From
+		}
+		if res[i].To != res[j].To {
+			return res[i].To < res[j].To
+		}
+		return res[i].Relation < res[j].Relation
+	})
+
+	return res
+}
+
+// familyBase follows the parent game and version parent of the Game with
+// the provided ID until it reaches a Game with neither, or a cycle.
+func familyBase(f *Family, id int) int {
+	visited := make(map[int]bool)
+	for !visited[id] {
+		visited[id] = true
+
+		g, ok := f.Games[id]
+		if !ok {
+			break
+		}
+
+		switch {
+		case g.ParentGame != 0:
+			id = g.ParentGame
+		case g.VersionParent != 0:
+			id = g.VersionParent
+		default:
+			return id
+		}
+	}
+
+	return id
+}
@@ -0,0 +1,177 @@
+package igdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// testFamilyGames mocks a family of Games where Game 1 is the base game of
+// DLC 2, bundle 3, edition 4, and mod 5. DLC 2 only carries its game type
+// and also lists Game 1 as its parent game, forming a cycle.
+var testFamilyGames = []*Game{
+	{ID: 1, Category: MainGame, DLCS: []int{2}, Bundles: []int{3}},
+	{ID: 2, GameType: int(DLCAddon), ParentGame: 1},
+	{ID: 3, Category: Bundle},
+	{ID: 4, Category: MainGame, VersionParent: 1},
+	{ID: 5, Category: Mod, ParentGame: 1},
+	{ID: 9, Category: MainGame},
+}
+
+// testFamilyServer initializes and returns a test server that responds to
+// Game requests filtered by ID, parent game, or version parent with the
+// matching testFamilyGames, along with a Client configured for it.
+func testFamilyServer() (*httptest.Server, *Client) {
+	list := regexp.MustCompile(`= \(([0-9,]+)\)`)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		m := list.FindStringSubmatch(string(b))
+		if m == nil || strings.Contains(string(b), "offset 500") {
+			w.Write([]byte("[]"))
+			return
+		}
+
+		ids := make(map[int]bool)
+		for _, s := range strings.Split(m[1], ",") {
+			id, _ := strconv.Atoi(s)
+			ids[id] = true
+		}
+
+		children := strings.Contains(string(b), "parent_game")
+
+		res := []*Game{}
+		for _, g := range testFamilyGames {
+			if (!children && ids[g.ID]) || (children && (ids[g.ParentGame] || ids[g.VersionParent])) {
+				res = append(res, g)
+			}
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	return ts, c
+}
+
+func TestGameService_Family(t *testing.T) {
+	ts, c := testFamilyServer()
+	defer ts.Close()
+
+	f, err := c.Games.Family(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if f.Root != 2 || f.Base != 1 {
+		t.Errorf("got: <root %v, base %v>, want: <root %v, base %v>", f.Root, f.Base, 2, 1)
+	}
+
+	if len(f.Games) != 5 {
+		t.Errorf("got: <%v> games, want: <%v> games", len(f.Games), 5)
+	}
+
+	if _, ok := f.Games[9]; ok {
+		t.Errorf("got: <game 9>, want: <no unrelated games>")
+	}
+
+	want := []FamilyEdge{
+		{From: 1, To: 2, Relation: FamilyDLC},
+		{From: 1, To: 3, Relation: FamilyBundle},
+		{From: 1, To: 4, Relation: FamilyVersion},
+		{From: 1, To: 5, Relation: FamilyChild},
+	}
+	if !reflect.DeepEqual(f.Edges, want) {
+		t.Errorf("got: <%v>, \nwant: <%v>", f.Edges, want)
+	}
+
+	if e := f.Children(1, FamilyDLC, FamilyVersion); len(e) != 2 {
+		t.Errorf("got: <%v>, want: <DLC and version edges>", e)
+	}
+
+	if e := f.Parents(4); len(e) != 1 || e[0].From != 1 {
+		t.Errorf("got: <%v>, want: <version edge from game 1>", e)
+	}
+}
+
+func TestGameService_FamilyErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		id      int
+		wantErr error
+	}{
+		{"Negative ID", http.StatusOK, "[]", -1, ErrNegativeID},
+		{"No results", http.StatusOK, "[]", 1, ErrNoResults},
+		{"Empty response", http.StatusOK, "", 1, errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", 1, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			f, err := c.Games.Family(test.id)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if f != nil {
+				t.Errorf("got: <%v>, want: <nil>", f)
+			}
+		})
+	}
+}
+
+func TestParentRelation(t *testing.T) {
+	var tests = []struct {
+		cat     GameCategory
+		wantRel FamilyRelation
+	}{
+		{DLCAddon, FamilyDLC},
+		{Expansion, FamilyExpansion},
+		{StandaloneExpansion, FamilyStandaloneExpansion},
+		{Episode, FamilyChild},
+		{MainGame, FamilyChild},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cat.String(), func(t *testing.T) {
+			if rel := parentRelation(test.cat); rel != test.wantRel {
+				t.Errorf("got: <%v>, want: <%v>", rel, test.wantRel)
+			}
+		})
+	}
+}
+
+func TestGameCategory(t *testing.T) {
+	var tests = []struct {
+		name    string
+		game    *Game
+		wantCat GameCategory
+	}{
+		{"Category only", &Game{Category: Expansion}, Expansion},
+		{"Game type only", &Game{GameType: int(DLCAddon)}, DLCAddon},
+		{"Both", &Game{Category: StandaloneExpansion, GameType: int(StandaloneExpansion)}, StandaloneExpansion},
+		{"Neither", &Game{}, MainGame},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if cat := gameCategory(test.game); cat != test.wantCat {
+				t.Errorf("got: <%v>, want: <%v>", cat, test.wantCat)
+			}
+		})
+	}
+}
@@ -12,12 +12,22 @@ import (
 // Franchise is a list of video game franchises such as Star Wars.
 // For more information visit: https://api-docs.igdb.com/#franchise
 type Franchise struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	Url       string `json:"url"`
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Games     []int     `json:"games"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	Url       string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the Franchise and
+// records which of its fields were present or unrecognized.
+func (f *Franchise) UnmarshalJSON(b []byte) error {
+	type franchise Franchise
+	return decodeModel(b, (*franchise)(f), &f.Presence, &f.Extras)
 }
 
 // FranchiseService handles all the API calls for the IGDB Franchise endpoint.
@@ -42,6 +52,25 @@ func (fs *FranchiseService) Get(id int, opts ...Option) (*Franchise, error) {
 	return fr[0], nil
 }
 
+// GetBySlug returns a single Franchise identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Franchises, an error is returned.
+func (fs *FranchiseService) GetBySlug(slug string, opts ...Option) (*Franchise, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var fr []*Franchise
+
+	opts = append(opts, setSlug(slug))
+	err := fs.client.post(fs.end, &fr, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Franchise with slug %s", slug)
+	}
+
+	return fr[0], nil
+}
+
 // List returns a list of Franchises identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Franchise is ignored. If none of the IDs
@@ -82,6 +111,21 @@ func (fs *FranchiseService) Index(opts ...Option) ([]*Franchise, error) {
 	return fr, nil
 }
 
+// Search returns a list of Franchises found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no Franchises are found using the provided query, an error is returned.
+func (fs *FranchiseService) Search(qry string, opts ...Option) ([]*Franchise, error) {
+	var fr []*Franchise
+
+	opts = append(opts, setSearch(qry))
+	err := fs.client.post(fs.end, &fr, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Franchise with query %s", qry)
+	}
+
+	return fr, nil
+}
+
 // Count returns the number of Franchises available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which Franchises to count.
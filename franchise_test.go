@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	testFranchiseGet  string = "test_data/franchise_get.json"
-	testFranchiseList string = "test_data/franchise_list.json"
+	testFranchiseGet    string = "test_data/franchise_get.json"
+	testFranchiseList   string = "test_data/franchise_list.json"
+	testFranchiseSearch string = "test_data/franchise_search.json"
 )
 
 func TestFranchiseService_Get(t *testing.T) {
@@ -60,6 +61,52 @@ func TestFranchiseService_Get(t *testing.T) {
 	}
 }
 
+func TestFranchiseService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testFranchiseGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Franchise, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name          string
+		file          string
+		slug          string
+		opts          []Option
+		wantFranchise *Franchise
+		wantErr       error
+	}{
+		{"Valid response", testFranchiseGet, "dungeons-dragons", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "dungeons-dragons", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "dungeons-dragons", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			fr, err := c.Franchises.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(fr, test.wantFranchise) {
+				t.Errorf("got: <%v>, \nwant: <%v>", fr, test.wantFranchise)
+			}
+		})
+	}
+}
+
 func TestFranchiseService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testFranchiseList)
 	if err != nil {
@@ -151,6 +198,52 @@ func TestFranchiseService_Index(t *testing.T) {
 	}
 }
 
+func TestFranchiseService_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(testFranchiseSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Franchise, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name           string
+		file           string
+		qry            string
+		opts           []Option
+		wantFranchises []*Franchise
+		wantErr        error
+	}{
+		{"Valid response", testFranchiseSearch, "harry", []Option{SetLimit(50)}, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "harry", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "harry", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			fr, err := c.Franchises.Search(test.qry, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(fr, test.wantFranchises) {
+				t.Errorf("got: <%v>, \nwant: <%v>", fr, test.wantFranchises)
+			}
+		})
+	}
+}
+
 func TestFranchiseService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
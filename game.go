@@ -11,6 +11,8 @@ import (
 // Game contains information on an IGDB entry for a particular video game.
 // For more information visit: https://api-docs.igdb.com/#game
 type Game struct {
+	Presence
+	Extras
 	ID                    int          `json:"id"`
 	AgeRatings            []int        `json:"age_ratings"`
 	AggregatedRating      float64      `json:"aggregated_rating"`
@@ -19,30 +21,41 @@ type Game struct {
 	Artworks              []int        `json:"artworks"`
 	Bundles               []int        `json:"bundles"`
 	Category              GameCategory `json:"category"`
+	Checksum              string       `json:"checksum"`
 	Collection            int          `json:"collection"`
+	Collections           []int        `json:"collections"`
 	Cover                 int          `json:"cover"`
-	CreatedAt             int          `json:"created_at"`
+	CreatedAt             Timestamp    `json:"created_at"`
 	DLCS                  []int        `json:"dlcs"`
+	ExpandedGames         []int        `json:"expanded_games"`
 	Expansions            []int        `json:"expansions"`
 	ExternalGames         []int        `json:"external_games"`
-	FirstReleaseDate      int          `json:"first_release_date"`
+	FirstReleaseDate      Timestamp    `json:"first_release_date"`
 	Follows               int          `json:"follows"`
+	Forks                 []int        `json:"forks"`
 	Franchise             int          `json:"franchise"`
 	Franchises            []int        `json:"franchises"`
 	GameEngines           []int        `json:"game_engines"`
+	GameLocalizations     []int        `json:"game_localizations"`
 	GameModes             []int        `json:"game_modes"`
+	GameStatusID          int          `json:"game_status"`
+	GameType              int          `json:"game_type"`
 	Genres                []int        `json:"genres"`
 	Hypes                 int          `json:"hypes"`
 	InvolvedCompanies     []int        `json:"involved_companies"`
 	Keywords              []int        `json:"keywords"`
+	LanguageSupports      []int        `json:"language_supports"`
 	MultiplayerModes      []int        `json:"multiplayer_modes"`
 	Name                  string       `json:"name"`
 	ParentGame            int          `json:"parent_game"`
 	Platforms             []int        `json:"platforms"`
 	PlayerPerspectives    []int        `json:"player_perspectives"`
+	Ports                 []int        `json:"ports"`
 	Rating                float64      `json:"rating"`
 	RatingCount           int          `json:"rating_count"`
 	ReleaseDates          []int        `json:"release_dates"`
+	Remakes               []int        `json:"remakes"`
+	Remasters             []int        `json:"remasters"`
 	Screenshots           []int        `json:"screenshots"`
 	SimilarGames          []int        `json:"similar_games"`
 	Slug                  string       `json:"slug"`
@@ -54,7 +67,7 @@ type Game struct {
 	Themes                []int        `json:"themes"`
 	TotalRating           float64      `json:"total_rating"`
 	TotalRatingCount      int          `json:"total_rating_count"`
-	UpdatedAt             int          `json:"updated_at"`
+	UpdatedAt             Timestamp    `json:"updated_at"`
 	URL                   string       `json:"url"`
 	VersionParent         int          `json:"version_parent"`
 	VersionTitle          string       `json:"version_title"`
@@ -62,7 +75,17 @@ type Game struct {
 	Websites              []int        `json:"websites"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the Game and
+// records which of its fields were present or unrecognized.
+func (g *Game) UnmarshalJSON(b []byte) error {
+	type game Game
+	return decodeModel(b, (*game)(g), &g.Presence, &g.Extras)
+}
+
 // GameCategory specifies a type of game content.
+//
+// The IGDB v4 deprecates the category field of a Game in favor
+// of the game_type field, which holds a Game Type ID instead.
 type GameCategory int
 
 //go:generate stringer -type=GameCategory,GameStatus
@@ -80,6 +103,9 @@ const (
 )
 
 // GameStatus specifies the release status of a specific game.
+//
+// The IGDB v4 deprecates the status field of a Game in favor
+// of the game_status field, which holds a Game Status ID instead.
 type GameStatus int
 
 // Expected GameStatus enums from the IGDB.
@@ -116,6 +142,25 @@ func (gs *GameService) Get(id int, opts ...Option) (*Game, error) {
 	return g[0], nil
 }
 
+// GetBySlug returns a single Game identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Games, an error is returned.
+func (gs *GameService) GetBySlug(slug string, opts ...Option) (*Game, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var g []*Game
+
+	opts = append(opts, setSlug(slug))
+	err := gs.client.post(gs.end, &g, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Game with slug %s", slug)
+	}
+
+	return g[0], nil
+}
+
 // List returns a list of Games identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Game is ignored. If none of the IDs
@@ -62,6 +62,52 @@ func TestGameService_Get(t *testing.T) {
 	}
 }
 
+func TestGameService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Game, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		file     string
+		slug     string
+		opts     []Option
+		wantGame *Game
+		wantErr  error
+	}{
+		{"Valid response", testGameGet, "the-legend-of-zelda-breath-of-the-wild", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "the-legend-of-zelda-breath-of-the-wild", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "the-legend-of-zelda-breath-of-the-wild", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			g, err := c.Games.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(g, test.wantGame) {
+				t.Errorf("got: <%v>, \nwant: <%v>", g, test.wantGame)
+			}
+		})
+	}
+}
+
 func TestGameService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testGameList)
 	if err != nil {
@@ -349,3 +395,31 @@ func ExampleGameService_Fields() {
 
 	fmt.Println("List of available fields for the IGDB Game object: ", fl)
 }
+
+func TestGame_V4Fields(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var g []*Game
+	if err = json.Unmarshal(f, &g); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(g[0].ExtraNames()) > 0 {
+		t.Errorf("got unrecognized fields: <%v>, want: <none>", g[0].ExtraNames())
+	}
+
+	if g[0].Checksum == "" || g[0].GameType != 0 || !g[0].Has("game_type") {
+		t.Errorf("got: <%v, %v>, want: <checksum and game type 0>", g[0].Checksum, g[0].GameType)
+	}
+
+	if !reflect.DeepEqual(g[0].Collections, []int{106}) {
+		t.Errorf("got: <%v>, want: <%v>", g[0].Collections, []int{106})
+	}
+
+	if len(g[0].GameLocalizations) != 2 || len(g[0].LanguageSupports) != 4 {
+		t.Errorf("got: <%v, %v>, want: <2 localizations, 4 language supports>", g[0].GameLocalizations, g[0].LanguageSupports)
+	}
+}
@@ -11,16 +11,25 @@ import (
 // GameEngine represents a video game engine such as Unreal Engine.
 // For more information visit: https://api-docs.igdb.com/#game-engine
 type GameEngine struct {
-	ID          int    `json:"id"`
-	Companies   []int  `json:"companies"`
-	CreatedAt   int    `json:"created_at"`
-	Description string `json:"description"`
-	Logo        int    `json:"logo"`
-	Name        string `json:"name"`
-	Platforms   []int  `json:"platforms"`
-	Slug        string `json:"slug"`
-	UpdatedAt   int    `json:"updated_at"`
-	URL         string `json:"url"`
+	Presence
+	Extras
+	ID          int       `json:"id"`
+	Companies   []int     `json:"companies"`
+	CreatedAt   Timestamp `json:"created_at"`
+	Description string    `json:"description"`
+	Logo        int       `json:"logo"`
+	Name        string    `json:"name"`
+	Platforms   []int     `json:"platforms"`
+	Slug        string    `json:"slug"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+	URL         string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the GameEngine and
+// records which of its fields were present or unrecognized.
+func (g *GameEngine) UnmarshalJSON(b []byte) error {
+	type gameEngine GameEngine
+	return decodeModel(b, (*gameEngine)(g), &g.Presence, &g.Extras)
 }
 
 // GameEngineService handles all the API calls for the IGDB GameEngine endpoint.
@@ -45,6 +54,25 @@ func (gs *GameEngineService) Get(id int, opts ...Option) (*GameEngine, error) {
 	return eng[0], nil
 }
 
+// GetBySlug returns a single GameEngine identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any GameEngines, an error is returned.
+func (gs *GameEngineService) GetBySlug(slug string, opts ...Option) (*GameEngine, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var eng []*GameEngine
+
+	opts = append(opts, setSlug(slug))
+	err := gs.client.post(gs.end, &eng, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameEngine with slug %s", slug)
+	}
+
+	return eng[0], nil
+}
+
 // List returns a list of GameEngines identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a GameEngine is ignored. If none of the IDs
@@ -85,6 +113,21 @@ func (gs *GameEngineService) Index(opts ...Option) ([]*GameEngine, error) {
 	return eng, nil
 }
 
+// Search returns a list of GameEngines found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no GameEngines are found using the provided query, an error is returned.
+func (gs *GameEngineService) Search(qry string, opts ...Option) ([]*GameEngine, error) {
+	var eng []*GameEngine
+
+	opts = append(opts, setSearch(qry))
+	err := gs.client.post(gs.end, &eng, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameEngine with query %s", qry)
+	}
+
+	return eng, nil
+}
+
 // Count returns the number of GameEngines available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which GameEngines to count.
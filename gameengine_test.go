@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	testGameEngineGet  string = "test_data/gameengine_get.json"
-	testGameEngineList string = "test_data/gameengine_list.json"
+	testGameEngineGet    string = "test_data/gameengine_get.json"
+	testGameEngineList   string = "test_data/gameengine_list.json"
+	testGameEngineSearch string = "test_data/gameengine_search.json"
 )
 
 func TestGameEngineService_Get(t *testing.T) {
@@ -60,6 +61,52 @@ func TestGameEngineService_Get(t *testing.T) {
 	}
 }
 
+func TestGameEngineService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameEngineGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameEngine, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name           string
+		file           string
+		slug           string
+		opts           []Option
+		wantGameEngine *GameEngine
+		wantErr        error
+	}{
+		{"Valid response", testGameEngineGet, "microsoft-xna", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "microsoft-xna", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "microsoft-xna", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			eng, err := c.GameEngines.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(eng, test.wantGameEngine) {
+				t.Errorf("got: <%v>, \nwant: <%v>", eng, test.wantGameEngine)
+			}
+		})
+	}
+}
+
 func TestGameEngineService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testGameEngineList)
 	if err != nil {
@@ -151,6 +198,52 @@ func TestGameEngineService_Index(t *testing.T) {
 	}
 }
 
+func TestGameEngineService_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameEngineSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameEngine, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name            string
+		file            string
+		qry             string
+		opts            []Option
+		wantGameEngines []*GameEngine
+		wantErr         error
+	}{
+		{"Valid response", testGameEngineSearch, "engine", []Option{SetLimit(50)}, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "engine", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "engine", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			eng, err := c.GameEngines.Search(test.qry, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(eng, test.wantGameEngines) {
+				t.Errorf("got: <%v>, \nwant: <%v>", eng, test.wantGameEngines)
+			}
+		})
+	}
+}
+
 func TestGameEngineService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
@@ -10,9 +10,18 @@ import (
 // For more information visit: https://api-docs.igdb.com/#game-engine-logo
 type GameEngineLogo struct {
 	Image
+	Presence
+	Extras
 	ID int `json:"id"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the GameEngineLogo and
+// records which of its fields were present or unrecognized.
+func (g *GameEngineLogo) UnmarshalJSON(b []byte) error {
+	type gameEngineLogo GameEngineLogo
+	return decodeModel(b, (*gameEngineLogo)(g), &g.Presence, &g.Extras)
+}
+
 // GameEngineLogoService handles all the API calls for the IGDB GameEngineLogo endpoint.
 type GameEngineLogoService service
 
@@ -0,0 +1,182 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/blank"
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct GameLocalization -add-tags json -w
+
+// GameLocalization represents the name and cover a particular game
+// is released with in a particular region.
+// For more information visit: https://api-docs.igdb.com/#game-localization
+type GameLocalization struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	Cover     int       `json:"cover"`
+	CreatedAt Timestamp `json:"created_at"`
+	Game      int       `json:"game"`
+	Name      string    `json:"name"`
+	Region    int       `json:"region"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the GameLocalization and
+// records which of its fields were present or unrecognized.
+func (g *GameLocalization) UnmarshalJSON(b []byte) error {
+	type gameLocalization GameLocalization
+	return decodeModel(b, (*gameLocalization)(g), &g.Presence, &g.Extras)
+}
+
+// GameLocalizationService handles all the API calls for the IGDB GameLocalization endpoint.
+type GameLocalizationService service
+
+// Get returns a single GameLocalization identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any GameLocalizations, an error is returned.
+func (gs *GameLocalizationService) Get(id int, opts ...Option) (*GameLocalization, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var loc []*GameLocalization
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.post(gs.end, &loc, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameLocalization with ID %v", id)
+	}
+
+	return loc[0], nil
+}
+
+// List returns a list of GameLocalizations identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a GameLocalization is ignored. If none of the IDs
+// match a GameLocalization, an error is returned.
+func (gs *GameLocalizationService) List(ids []int, opts ...Option) ([]*GameLocalization, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var loc []*GameLocalization
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := gs.client.post(gs.end, &loc, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameLocalizations with IDs %v", ids)
+	}
+
+	return loc, nil
+}
+
+// Index returns an index of GameLocalizations based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no GameLocalizations can
+// be found using the provided options, an error is returned.
+func (gs *GameLocalizationService) Index(opts ...Option) ([]*GameLocalization, error) {
+	var loc []*GameLocalization
+
+	err := gs.client.post(gs.end, &loc, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of GameLocalizations")
+	}
+
+	return loc, nil
+}
+
+// Count returns the number of GameLocalizations available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which GameLocalizations to count.
+func (gs *GameLocalizationService) Count(opts ...Option) (int, error) {
+	ct, err := gs.client.getCount(gs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count GameLocalizations")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB GameLocalization object.
+func (gs *GameLocalizationService) Fields() ([]string, error) {
+	f, err := gs.client.getFields(gs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get GameLocalization fields")
+	}
+
+	return f, nil
+}
+
+// ErrUnknownRegion occurs when a region is requested that does not match
+// the identifier or name of any Region in the IGDB.
+var ErrUnknownRegion = errors.New("region does not exist")
+
+// LocalizedGame contains the name and cover of a Game as it is released in a
+// particular Region. If the Game has no GameLocalization for the Region, or
+// its GameLocalization leaves out the name or cover, the default Name or
+// Cover of the Game is used instead and NameLocalized or CoverLocalized
+// reports false.
+type LocalizedGame struct {
+	Game           *Game  `json:"game"`
+	Region         int    `json:"region"`
+	Name           string `json:"name"`
+	Cover          int    `json:"cover"`
+	NameLocalized  bool   `json:"name_localized"`
+	CoverLocalized bool   `json:"cover_localized"`
+}
+
+// Localize returns the name and cover of the provided Game for the Region
+// with the provided identifier (e.g. "ja-JP") or name (e.g. "Japan"), falling
+// back to the default Name and Cover of the Game when it has no localized
+// version. The provided Game must have been retrieved with its ID, name, and
+// cover fields. If the Region does not exist, an error is returned.
+func (gs *GameLocalizationService) Localize(g *Game, region string) (*LocalizedGame, error) {
+	if g == nil {
+		return nil, errors.Wrap(ErrUnsupportedType, "cannot localize nil Game")
+	}
+
+	if g.ID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	reg, err := gs.client.Regions.byIdentifier(region)
+	if err != nil {
+		return nil, err
+	}
+
+	lg := &LocalizedGame{Game: g, Region: reg.ID, Name: g.Name, Cover: g.Cover}
+
+	var loc []*GameLocalization
+	err = gs.client.post(gs.end, &loc,
+		SetFields("name", "cover"),
+		SetFilter("game", OpEquals, strconv.Itoa(g.ID)),
+		SetFilter("region", OpEquals, strconv.Itoa(reg.ID)),
+	)
+	if errors.Cause(err) == ErrNoResults {
+		return lg, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameLocalizations of Game with ID %v", g.ID)
+	}
+
+	for _, l := range loc {
+		if !lg.NameLocalized && !blank.Is(l.Name) {
+			lg.Name, lg.NameLocalized = l.Name, true
+		}
+		if !lg.CoverLocalized && l.Cover != 0 {
+			lg.Cover, lg.CoverLocalized = l.Cover, true
+		}
+	}
+
+	return lg, nil
+}
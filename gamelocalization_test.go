@@ -0,0 +1,281 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	testGameLocalizationGet  string = "test_data/gamelocalization_get.json"
+	testGameLocalizationList string = "test_data/gamelocalization_list.json"
+)
+
+func TestGameLocalizationService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameLocalizationGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameLocalization, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                 string
+		file                 string
+		id                   int
+		opts                 []Option
+		wantGameLocalization *GameLocalization
+		wantErr              error
+	}{
+		{"Valid response", testGameLocalizationGet, 1171, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1171, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1171, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			loc, err := c.GameLocalizations.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(loc, test.wantGameLocalization) {
+				t.Errorf("got: <%v>, \nwant: <%v>", loc, test.wantGameLocalization)
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameLocalizationList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameLocalization, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                  string
+		file                  string
+		ids                   []int
+		opts                  []Option
+		wantGameLocalizations []*GameLocalization
+		wantErr               error
+	}{
+		{"Valid response", testGameLocalizationList, []int{1171, 1172, 1203, 1204, 1310}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{1171, 1172, 1203, 1204, 1310}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{1171, 1172, 1203, 1204, 1310}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			loc, err := c.GameLocalizations.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(loc, test.wantGameLocalizations) {
+				t.Errorf("got: <%v>, \nwant: <%v>", loc, test.wantGameLocalizations)
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameLocalizationList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameLocalization, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                  string
+		file                  string
+		opts                  []Option
+		wantGameLocalizations []*GameLocalization
+		wantErr               error
+	}{
+		{"Valid response", testGameLocalizationList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			loc, err := c.GameLocalizations.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(loc, test.wantGameLocalizations) {
+				t.Errorf("got: <%v>, \nwant: <%v>", loc, test.wantGameLocalizations)
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.GameLocalizations.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.GameLocalizations.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
+
+func TestGameLocalizationService_Localize(t *testing.T) {
+	regions, err := ioutil.ReadFile("test_data/region_list.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := &Game{ID: 7346, Name: "The Legend of Zelda: Breath of the Wild", Cover: 172453}
+
+	var tests = []struct {
+		name     string
+		locs     string
+		game     *Game
+		region   string
+		wantGame *LocalizedGame
+		wantErr  error
+	}{
+		{
+			"Localized name and cover",
+			`[{"id": 1171, "name": "ゼルダの伝説 ブレス オブ ザ ワイルド", "cover": 213845}]`,
+			g,
+			"ja-jp",
+			&LocalizedGame{Game: g, Region: 3, Name: "ゼルダの伝説 ブレス オブ ザ ワイルド", Cover: 213845, NameLocalized: true, CoverLocalized: true},
+			nil,
+		},
+		{
+			"Localized name only",
+			`[{"id": 1172, "name": "젤다의 전설 브레스 오브 더 와일드"}]`,
+			g,
+			"Korea",
+			&LocalizedGame{Game: g, Region: 6, Name: "젤다의 전설 브레스 오브 더 와일드", Cover: 172453, NameLocalized: true},
+			nil,
+		},
+		{
+			"No localization",
+			"[]",
+			g,
+			"eu",
+			&LocalizedGame{Game: g, Region: 1, Name: g.Name, Cover: g.Cover},
+			nil,
+		},
+		{"Unknown region", "[]", g, "Atlantis", nil, ErrUnknownRegion},
+		{"Empty region", "[]", g, "", nil, ErrEmptyQry},
+		{"Nil game", "[]", nil, "eu", nil, ErrUnsupportedType},
+		{"Invalid ID", "[]", &Game{ID: -1}, "eu", nil, ErrNegativeID},
+		{"Empty response", "", g, "eu", nil, errInvalidJSON},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRoutes(map[string]string{
+				"/regions/":            string(regions),
+				"/game_localizations/": test.locs,
+			})
+			defer ts.Close()
+
+			lg, err := c.GameLocalizations.Localize(test.game, test.region)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(lg, test.wantGame) {
+				t.Errorf("got: <%v>, \nwant: <%v>", lg, test.wantGame)
+			}
+		})
+	}
+}
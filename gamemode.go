@@ -11,11 +11,21 @@ import (
 // GameMode represents a video game mode such as single or multi player.
 // For more information visit: https://api-docs.igdb.com/#game-mode
 type GameMode struct {
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the GameMode and
+// records which of its fields were present or unrecognized.
+func (g *GameMode) UnmarshalJSON(b []byte) error {
+	type gameMode GameMode
+	return decodeModel(b, (*gameMode)(g), &g.Presence, &g.Extras)
 }
 
 // GameModeService handles all the API calls for the IGDB GameMode endpoint.
@@ -40,6 +50,25 @@ func (gs *GameModeService) Get(id int, opts ...Option) (*GameMode, error) {
 	return mode[0], nil
 }
 
+// GetBySlug returns a single GameMode identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any GameModes, an error is returned.
+func (gs *GameModeService) GetBySlug(slug string, opts ...Option) (*GameMode, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var mode []*GameMode
+
+	opts = append(opts, setSlug(slug))
+	err := gs.client.post(gs.end, &mode, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameMode with slug %s", slug)
+	}
+
+	return mode[0], nil
+}
+
 // List returns a list of GameModes identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a GameMode is ignored. If none of the IDs
@@ -0,0 +1,114 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct GameStatusLookup -add-tags json -w
+
+// GameStatusLookup represents a release status of a game such as alpha or
+// early access. It replaces the GameStatus enums in the IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#game-status
+type GameStatusLookup struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Status    string    `json:"status"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the GameStatusLookup and
+// records which of its fields were present or unrecognized.
+func (g *GameStatusLookup) UnmarshalJSON(b []byte) error {
+	type gameStatusLookup GameStatusLookup
+	return decodeModel(b, (*gameStatusLookup)(g), &g.Presence, &g.Extras)
+}
+
+// GameStatusLookupService handles all the API calls for the IGDB GameStatusLookup endpoint.
+type GameStatusLookupService service
+
+// Get returns a single GameStatusLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any GameStatusLookups, an error is returned.
+func (gs *GameStatusLookupService) Get(id int, opts ...Option) (*GameStatusLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var stat []*GameStatusLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.post(gs.end, &stat, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameStatusLookup with ID %v", id)
+	}
+
+	return stat[0], nil
+}
+
+// List returns a list of GameStatusLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a GameStatusLookup is ignored. If none of the IDs
+// match a GameStatusLookup, an error is returned.
+func (gs *GameStatusLookupService) List(ids []int, opts ...Option) ([]*GameStatusLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var stat []*GameStatusLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := gs.client.post(gs.end, &stat, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameStatusLookups with IDs %v", ids)
+	}
+
+	return stat, nil
+}
+
+// Index returns an index of GameStatusLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no GameStatusLookups can
+// be found using the provided options, an error is returned.
+func (gs *GameStatusLookupService) Index(opts ...Option) ([]*GameStatusLookup, error) {
+	var stat []*GameStatusLookup
+
+	err := gs.client.post(gs.end, &stat, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of GameStatusLookups")
+	}
+
+	return stat, nil
+}
+
+// Count returns the number of GameStatusLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which GameStatusLookups to count.
+func (gs *GameStatusLookupService) Count(opts ...Option) (int, error) {
+	ct, err := gs.client.getCount(gs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count GameStatusLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB GameStatusLookup object.
+func (gs *GameStatusLookupService) Fields() ([]string, error) {
+	f, err := gs.client.getFields(gs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get GameStatusLookup fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,174 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+	"time"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct GameTimeToBeat -add-tags json -w
+
+// GameTimeToBeat represents the average time it takes to finish a
+// particular game when hurrying, playing normally, or completing it.
+// For more information visit: https://api-docs.igdb.com/#game-time-to-beat
+type GameTimeToBeat struct {
+	Presence
+	Extras
+	ID         int       `json:"id"`
+	Checksum   string    `json:"checksum"`
+	Completely Seconds   `json:"completely"`
+	Count      int       `json:"count"`
+	CreatedAt  Timestamp `json:"created_at"`
+	GameID     int       `json:"game_id"`
+	Hastily    Seconds   `json:"hastily"`
+	Normally   Seconds   `json:"normally"`
+	UpdatedAt  Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the GameTimeToBeat and
+// records which of its fields were present or unrecognized.
+func (g *GameTimeToBeat) UnmarshalJSON(b []byte) error {
+	type gameTimeToBeat GameTimeToBeat
+	return decodeModel(b, (*gameTimeToBeat)(g), &g.Presence, &g.Extras)
+}
+
+// Seconds is a length of time represented by a number of seconds. The IGDB
+// uses this format for the time it takes to beat a game. Seconds decodes
+// from and encodes to the same JSON numbers as a plain int.
+type Seconds int
+
+// Duration returns the Seconds as a time.Duration.
+func (s Seconds) Duration() time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+// GameTimeToBeatService handles all the API calls for the IGDB GameTimeToBeat endpoint.
+type GameTimeToBeatService service
+
+// Get returns a single GameTimeToBeat identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any GameTimeToBeats, an error is returned.
+func (gs *GameTimeToBeatService) Get(id int, opts ...Option) (*GameTimeToBeat, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var ttb []*GameTimeToBeat
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.post(gs.end, &ttb, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameTimeToBeat with ID %v", id)
+	}
+
+	return ttb[0], nil
+}
+
+// List returns a list of GameTimeToBeats identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a GameTimeToBeat is ignored. If none of the IDs
+// match a GameTimeToBeat, an error is returned.
+func (gs *GameTimeToBeatService) List(ids []int, opts ...Option) ([]*GameTimeToBeat, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var ttb []*GameTimeToBeat
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := gs.client.post(gs.end, &ttb, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameTimeToBeats with IDs %v", ids)
+	}
+
+	return ttb, nil
+}
+
+// Index returns an index of GameTimeToBeats based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no GameTimeToBeats can
+// be found using the provided options, an error is returned.
+func (gs *GameTimeToBeatService) Index(opts ...Option) ([]*GameTimeToBeat, error) {
+	var ttb []*GameTimeToBeat
+
+	err := gs.client.post(gs.end, &ttb, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of GameTimeToBeats")
+	}
+
+	return ttb, nil
+}
+
+// Count returns the number of GameTimeToBeats available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which GameTimeToBeats to count.
+func (gs *GameTimeToBeatService) Count(opts ...Option) (int, error) {
+	ct, err := gs.client.getCount(gs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count GameTimeToBeats")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB GameTimeToBeat object.
+func (gs *GameTimeToBeatService) Fields() ([]string, error) {
+	f, err := gs.client.getFields(gs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get GameTimeToBeat fields")
+	}
+
+	return f, nil
+}
+
+// ByGames returns the GameTimeToBeats of the Games identified by the provided
+// list of IGDB IDs, keyed by Game ID. The GameTimeToBeats are retrieved with
+// as few requests as the maximum limit of 500 results allows. Any ID that does
+// not match a GameTimeToBeat is left out. If none of the IDs match, an error
+// is returned.
+func (gs *GameTimeToBeatService) ByGames(ids []int) (map[int]*GameTimeToBeat, error) {
+	if len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	ids = uniqueIDs(ids)
+	byGame := make(map[int]*GameTimeToBeat, len(ids))
+	for start := 0; start < len(ids); start += maxLimit {
+		stop := start + maxLimit
+		if stop > len(ids) {
+			stop = len(ids)
+		}
+
+		var ttb []*GameTimeToBeat
+		err := gs.client.postAll(gs.end, &ttb,
+			SetFields("*"),
+			SetFilter("game_id", OpContainsAtLeast, sliceconv.Itoa(ids[start:stop])...),
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get GameTimeToBeats of Games with IDs %v", ids[start:stop])
+		}
+
+		for _, t := range ttb {
+			byGame[t.GameID] = t
+		}
+	}
+
+	if len(byGame) == 0 {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get GameTimeToBeats of Games with IDs %v", ids)
+	}
+
+	return byGame, nil
+}
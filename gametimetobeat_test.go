@@ -0,0 +1,295 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+const (
+	testGameTimeToBeatGet  string = "test_data/gametimetobeat_get.json"
+	testGameTimeToBeatList string = "test_data/gametimetobeat_list.json"
+)
+
+func TestGameTimeToBeatService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameTimeToBeatGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameTimeToBeat, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name               string
+		file               string
+		id                 int
+		opts               []Option
+		wantGameTimeToBeat *GameTimeToBeat
+		wantErr            error
+	}{
+		{"Valid response", testGameTimeToBeatGet, 1432, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1432, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1432, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ttb, err := c.GameTimeToBeats.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ttb, test.wantGameTimeToBeat) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ttb, test.wantGameTimeToBeat)
+			}
+		})
+	}
+}
+
+func TestGameTimeToBeatService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameTimeToBeatList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameTimeToBeat, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                string
+		file                string
+		ids                 []int
+		opts                []Option
+		wantGameTimeToBeats []*GameTimeToBeat
+		wantErr             error
+	}{
+		{"Valid response", testGameTimeToBeatList, []int{1432, 1433, 1434, 1435, 1436}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{1432, 1433, 1434, 1435, 1436}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{1432, 1433, 1434, 1435, 1436}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ttb, err := c.GameTimeToBeats.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ttb, test.wantGameTimeToBeats) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ttb, test.wantGameTimeToBeats)
+			}
+		})
+	}
+}
+
+func TestGameTimeToBeatService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testGameTimeToBeatList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*GameTimeToBeat, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                string
+		file                string
+		opts                []Option
+		wantGameTimeToBeats []*GameTimeToBeat
+		wantErr             error
+	}{
+		{"Valid response", testGameTimeToBeatList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ttb, err := c.GameTimeToBeats.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ttb, test.wantGameTimeToBeats) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ttb, test.wantGameTimeToBeats)
+			}
+		})
+	}
+}
+
+func TestGameTimeToBeatService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.GameTimeToBeats.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestGameTimeToBeatService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.GameTimeToBeats.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
+
+func TestSeconds_Duration(t *testing.T) {
+	var tests = []struct {
+		name    string
+		sec     Seconds
+		wantDur time.Duration
+	}{
+		{"Zero seconds", 0, 0},
+		{"Hours", 306000, 85 * time.Hour},
+		{"Minutes and seconds", 3725, time.Hour + 2*time.Minute + 5*time.Second},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if d := test.sec.Duration(); d != test.wantDur {
+				t.Errorf("got: <%v>, want: <%v>", d, test.wantDur)
+			}
+		})
+	}
+}
+
+func TestGameTimeToBeatService_ByGames(t *testing.T) {
+	var tests = []struct {
+		name     string
+		file     string
+		ids      []int
+		wantGame []int
+		wantErr  error
+	}{
+		{"Valid response", testGameTimeToBeatList, []int{7346, 1942, 119133, 1289, 11065, 7346}, []int{1289, 1942, 7346, 11065, 119133}, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-1}, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{7346}, nil, errInvalidJSON},
+		{"No results", testFileEmptyArray, []int{9999999}, nil, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			byGame, err := c.GameTimeToBeats.ByGames(test.ids)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			var games []int
+			for id, ttb := range byGame {
+				if ttb.GameID != id {
+					t.Errorf("got: <%v>, want: <%v>", ttb.GameID, id)
+				}
+				games = append(games, id)
+			}
+			sort.Ints(games)
+
+			if !reflect.DeepEqual(games, test.wantGame) {
+				t.Errorf("got: <%v>, want: <%v>", games, test.wantGame)
+			}
+		})
+	}
+
+	ts, c, err := testServerFile(http.StatusOK, testGameTimeToBeatGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ts.Close()
+
+	byGame, err := c.GameTimeToBeats.ByGames([]int{7346})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d := byGame[7346].Normally.Duration(); d != 85*time.Hour {
+		t.Errorf("got: <%v>, want: <%v>", d, 85*time.Hour)
+	}
+}
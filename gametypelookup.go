@@ -0,0 +1,114 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct GameTypeLookup -add-tags json -w
+
+// GameTypeLookup represents a type of game content such as a main game, DLC,
+// or remaster. It replaces the GameCategory enums in the IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#game-type
+type GameTypeLookup struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Type      string    `json:"type"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the GameTypeLookup and
+// records which of its fields were present or unrecognized.
+func (g *GameTypeLookup) UnmarshalJSON(b []byte) error {
+	type gameTypeLookup GameTypeLookup
+	return decodeModel(b, (*gameTypeLookup)(g), &g.Presence, &g.Extras)
+}
+
+// GameTypeLookupService handles all the API calls for the IGDB GameTypeLookup endpoint.
+type GameTypeLookupService service
+
+// Get returns a single GameTypeLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any GameTypeLookups, an error is returned.
+func (gs *GameTypeLookupService) Get(id int, opts ...Option) (*GameTypeLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var typ []*GameTypeLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := gs.client.post(gs.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameTypeLookup with ID %v", id)
+	}
+
+	return typ[0], nil
+}
+
+// List returns a list of GameTypeLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a GameTypeLookup is ignored. If none of the IDs
+// match a GameTypeLookup, an error is returned.
+func (gs *GameTypeLookupService) List(ids []int, opts ...Option) ([]*GameTypeLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var typ []*GameTypeLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := gs.client.post(gs.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get GameTypeLookups with IDs %v", ids)
+	}
+
+	return typ, nil
+}
+
+// Index returns an index of GameTypeLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no GameTypeLookups can
+// be found using the provided options, an error is returned.
+func (gs *GameTypeLookupService) Index(opts ...Option) ([]*GameTypeLookup, error) {
+	var typ []*GameTypeLookup
+
+	err := gs.client.post(gs.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of GameTypeLookups")
+	}
+
+	return typ, nil
+}
+
+// Count returns the number of GameTypeLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which GameTypeLookups to count.
+func (gs *GameTypeLookupService) Count(opts ...Option) (int, error) {
+	ct, err := gs.client.getCount(gs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count GameTypeLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB GameTypeLookup object.
+func (gs *GameTypeLookupService) Fields() ([]string, error) {
+	f, err := gs.client.getFields(gs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get GameTypeLookup fields")
+	}
+
+	return f, nil
+}
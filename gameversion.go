@@ -11,12 +11,22 @@ import (
 // GameVersion provides details about game editions and versions.
 // For more information visit: https://api-docs.igdb.com/#game-version
 type GameVersion struct {
-	CreatedAt int    `json:"created_at"`
-	Features  []int  `json:"features"`
-	Game      int    `json:"game"`
-	Games     []int  `json:"games"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Features  []int     `json:"features"`
+	Game      int       `json:"game"`
+	Games     []int     `json:"games"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the GameVersion and
+// records which of its fields were present or unrecognized.
+func (g *GameVersion) UnmarshalJSON(b []byte) error {
+	type gameVersion GameVersion
+	return decodeModel(b, (*gameVersion)(g), &g.Presence, &g.Extras)
 }
 
 // GameVersionService handles all the API calls for the IGDB GameVersion endpoint.
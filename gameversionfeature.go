@@ -12,6 +12,8 @@ import (
 // each version/edition different from their main game.
 // For more information visit: https://api-docs.igdb.com/#game-version-feature
 type GameVersionFeature struct {
+	Presence
+	Extras
 	ID          int                    `json:"id"`
 	Category    VersionFeatureCategory `json:"category"`
 	Description string                 `json:"description"`
@@ -20,6 +22,13 @@ type GameVersionFeature struct {
 	Values      []int                  `json:"values"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the GameVersionFeature and
+// records which of its fields were present or unrecognized.
+func (g *GameVersionFeature) UnmarshalJSON(b []byte) error {
+	type gameVersionFeature GameVersionFeature
+	return decodeModel(b, (*gameVersionFeature)(g), &g.Presence, &g.Extras)
+}
+
 //go:generate stringer -type=VersionFeatureCategory
 
 // VersionFeatureCategory specifies the type of feature for a particular game.
@@ -11,6 +11,8 @@ import (
 // GameVersionFeatureValue represents the bool/text value of a particular feature.
 // For more information visit: https://api-docs.igdb.com/#game-version-feature-value
 type GameVersionFeatureValue struct {
+	Presence
+	Extras
 	ID              int                     `json:"id"`
 	Game            int                     `json:"game"`
 	GameFeature     int                     `json:"game_feature"`
@@ -18,6 +20,13 @@ type GameVersionFeatureValue struct {
 	Note            string                  `json:"note"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the GameVersionFeatureValue and
+// records which of its fields were present or unrecognized.
+func (g *GameVersionFeatureValue) UnmarshalJSON(b []byte) error {
+	type gameVersionFeatureValue GameVersionFeatureValue
+	return decodeModel(b, (*gameVersionFeatureValue)(g), &g.Presence, &g.Extras)
+}
+
 //go:generate stringer -type=VersionFeatureInclusion
 
 // VersionFeatureInclusion specifies whether a feature is included or not.
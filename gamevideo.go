@@ -11,11 +11,21 @@ import (
 // GameVideo represents a video associated with a particular game.
 // For more information visit: https://api-docs.igdb.com/#game-video
 type GameVideo struct {
+	Presence
+	Extras
+	ID      int    `json:"id"`
 	Game    int    `json:"game"`
 	Name    string `json:"name"`
 	VideoID string `json:"video_id"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the GameVideo and
+// records which of its fields were present or unrecognized.
+func (g *GameVideo) UnmarshalJSON(b []byte) error {
+	type gameVideo GameVideo
+	return decodeModel(b, (*gameVideo)(g), &g.Presence, &g.Extras)
+}
+
 // GameVideoService handles all the API calls for the IGDB GameVideo endpoint.
 type GameVideoService service
 
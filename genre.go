@@ -11,12 +11,21 @@ import (
 // Genre represents the genre of a particular video game.
 // For more information visit: https://api-docs.igdb.com/#genre
 type Genre struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the Genre and
+// records which of its fields were present or unrecognized.
+func (g *Genre) UnmarshalJSON(b []byte) error {
+	type genre Genre
+	return decodeModel(b, (*genre)(g), &g.Presence, &g.Extras)
 }
 
 // GenreService handles all the API calls for the IGDB Genre endpoint.
@@ -41,6 +50,25 @@ func (gs *GenreService) Get(id int, opts ...Option) (*Genre, error) {
 	return gen[0], nil
 }
 
+// GetBySlug returns a single Genre identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Genres, an error is returned.
+func (gs *GenreService) GetBySlug(slug string, opts ...Option) (*Genre, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var gen []*Genre
+
+	opts = append(opts, setSlug(slug))
+	err := gs.client.post(gs.end, &gen, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Genre with slug %s", slug)
+	}
+
+	return gen[0], nil
+}
+
 // List returns a list of Genres identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Genre is ignored. If none of the IDs
@@ -60,6 +60,52 @@ func TestGenreService_Get(t *testing.T) {
 	}
 }
 
+func TestGenreService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testGenreGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Genre, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		slug      string
+		opts      []Option
+		wantGenre *Genre
+		wantErr   error
+	}{
+		{"Valid response", testGenreGet, "simulator", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "simulator", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "simulator", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			gen, err := c.Genres.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(gen, test.wantGenre) {
+				t.Errorf("got: <%v>, \nwant: <%v>", gen, test.wantGenre)
+			}
+		})
+	}
+}
+
 func TestGenreService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testGenreList)
 	if err != nil {
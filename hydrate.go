@@ -0,0 +1,288 @@
+package igdb
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownRelation occurs when a relation is requested
+// that does not reference a supported IGDB object type.
+var ErrUnknownRelation = errors.New("relation does not reference a supported object type")
+
+// GameRelation is a field of a Game that references other IGDB objects by
+// their IDs. A GameRelation is named after the JSON tag of its field.
+type GameRelation string
+
+// Available relations for the Hydrate function of the GameService.
+const (
+	HydrateAgeRatings           GameRelation = "age_ratings"
+	HydrateAlternativeNames     GameRelation = "alternative_names"
+	HydrateArtworks             GameRelation = "artworks"
+	HydrateBundles              GameRelation = "bundles"
+	HydrateCollection           GameRelation = "collection"
+	HydrateCollections          GameRelation = "collections"
+	HydrateCover                GameRelation = "cover"
+	HydrateDLCS                 GameRelation = "dlcs"
+	HydrateExpandedGames        GameRelation = "expanded_games"
+	HydrateExpansions           GameRelation = "expansions"
+	HydrateExternalGames        GameRelation = "external_games"
+	HydrateForks                GameRelation = "forks"
+	HydrateFranchise            GameRelation = "franchise"
+	HydrateFranchises           GameRelation = "franchises"
+	HydrateGameEngines          GameRelation = "game_engines"
+	HydrateGameLocalizations    GameRelation = "game_localizations"
+	HydrateGameModes            GameRelation = "game_modes"
+	HydrateGameStatus           GameRelation = "game_status"
+	HydrateGameType             GameRelation = "game_type"
+	HydrateGenres               GameRelation = "genres"
+	HydrateInvolvedCompanies    GameRelation = "involved_companies"
+	HydrateKeywords             GameRelation = "keywords"
+	HydrateLanguageSupports     GameRelation = "language_supports"
+	HydrateMultiplayerModes     GameRelation = "multiplayer_modes"
+	HydrateParentGame           GameRelation = "parent_game"
+	HydratePlatforms            GameRelation = "platforms"
+	HydratePlayerPerspectives   GameRelation = "player_perspectives"
+	HydratePorts                GameRelation = "ports"
+	HydrateReleaseDates         GameRelation = "release_dates"
+	HydrateRemakes              GameRelation = "remakes"
+	HydrateRemasters            GameRelation = "remasters"
+	HydrateScreenshots          GameRelation = "screenshots"
+	HydrateSimilarGames         GameRelation = "similar_games"
+	HydrateStandaloneExpansions GameRelation = "standalone_expansions"
+	HydrateThemes               GameRelation = "themes"
+	HydrateVersionParent        GameRelation = "version_parent"
+	HydrateVideos               GameRelation = "videos"
+	HydrateWebsites             GameRelation = "websites"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct HydratedGame -add-tags json -add-options json=omitempty -w
+
+// HydratedGame contains a Game along with the IGDB objects its relations
+// reference. Only the relations passed to the Hydrate function are
+// populated; the rest are left nil. Referenced objects that could not
+// be found in the IGDB are omitted.
+type HydratedGame struct {
+	Game                 *Game                `json:"game,omitempty"`
+	AgeRatings           []*AgeRating         `json:"age_ratings,omitempty"`
+	AlternativeNames     []*AlternativeName   `json:"alternative_names,omitempty"`
+	Artworks             []*Artwork           `json:"artworks,omitempty"`
+	Bundles              []*Game              `json:"bundles,omitempty"`
+	Collection           *Collection          `json:"collection,omitempty"`
+	Collections          []*Collection        `json:"collections,omitempty"`
+	Cover                *Cover               `json:"cover,omitempty"`
+	DLCS                 []*Game              `json:"dlcs,omitempty"`
+	ExpandedGames        []*Game              `json:"expanded_games,omitempty"`
+	Expansions           []*Game              `json:"expansions,omitempty"`
+	ExternalGames        []*ExternalGame      `json:"external_games,omitempty"`
+	Forks                []*Game              `json:"forks,omitempty"`
+	Franchise            *Franchise           `json:"franchise,omitempty"`
+	Franchises           []*Franchise         `json:"franchises,omitempty"`
+	GameEngines          []*GameEngine        `json:"game_engines,omitempty"`
+	GameLocalizations    []*GameLocalization  `json:"game_localizations,omitempty"`
+	GameModes            []*GameMode          `json:"game_modes,omitempty"`
+	GameStatus           *GameStatusLookup    `json:"game_status,omitempty"`
+	GameType             *GameTypeLookup      `json:"game_type,omitempty"`
+	Genres               []*Genre             `json:"genres,omitempty"`
+	InvolvedCompanies    []*InvolvedCompany   `json:"involved_companies,omitempty"`
+	Keywords             []*Keyword           `json:"keywords,omitempty"`
+	LanguageSupports     []*LanguageSupport   `json:"language_supports,omitempty"`
+	MultiplayerModes     []*MultiplayerMode   `json:"multiplayer_modes,omitempty"`
+	ParentGame           *Game                `json:"parent_game,omitempty"`
+	Platforms            []*Platform          `json:"platforms,omitempty"`
+	PlayerPerspectives   []*PlayerPerspective `json:"player_perspectives,omitempty"`
+	Ports                []*Game              `json:"ports,omitempty"`
+	ReleaseDates         []*ReleaseDate       `json:"release_dates,omitempty"`
+	Remakes              []*Game              `json:"remakes,omitempty"`
+	Remasters            []*Game              `json:"remasters,omitempty"`
+	Screenshots          []*Screenshot        `json:"screenshots,omitempty"`
+	SimilarGames         []*Game              `json:"similar_games,omitempty"`
+	StandaloneExpansions []*Game              `json:"standalone_expansions,omitempty"`
+	Themes               []*Theme             `json:"themes,omitempty"`
+	VersionParent        *Game                `json:"version_parent,omitempty"`
+	Videos               []*GameVideo         `json:"videos,omitempty"`
+	Websites             []*Website           `json:"websites,omitempty"`
+}
+
+// Hydrate resolves the provided relations of every provided Game into the
+// IGDB objects they reference. The IDs referenced by a relation are collected
+// across every Game so that each related endpoint is only requested as many
+// times as the maximum limit of 500 results requires, regardless of the number
+// of Games. The provided Games must have been retrieved with the fields of the
+// requested relations. The results are returned in the same order as the
+// provided Games, skipping any nil Games.
+func (gs *GameService) Hydrate(games []*Game, rels ...GameRelation) ([]*HydratedGame, error) {
+	hyd := make([]*HydratedGame, 0, len(games))
+	for _, g := range games {
+		if g != nil {
+			hyd = append(hyd, &HydratedGame{Game: g})
+		}
+	}
+
+	seen := make(map[GameRelation]bool)
+	for _, rel := range rels {
+		end, ok := references[EndpointGame][string(rel)]
+		if !ok {
+			return nil, errors.Wrapf(ErrUnknownRelation, "cannot hydrate Game relation '%s'", rel)
+		}
+
+		if seen[rel] {
+			continue
+		}
+		seen[rel] = true
+
+		var ids []int
+		for _, h := range hyd {
+			ids = append(ids, referencedIDs(fieldByTag(reflect.ValueOf(h.Game), string(rel)))...)
+		}
+
+		if len(ids) == 0 {
+			continue
+		}
+
+		found, err := gs.client.getByIDs(end, ids)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot hydrate Game relation '%s'", rel)
+		}
+
+		for _, h := range hyd {
+			refs := referencedIDs(fieldByTag(reflect.ValueOf(h.Game), string(rel)))
+			setReferences(fieldByTag(reflect.ValueOf(h), string(rel)), refs, found)
+		}
+	}
+
+	return hyd, nil
+}
+
+// getByIDs retrieves every object identified by the provided IDs from the
+// provided endpoint with as few requests as the maximum limit allows. The
+// objects are returned as pointers to the endpoint's struct type keyed by
+// their ID. Any ID that does not match an object is ignored.
+func (c *Client) getByIDs(end endpoint, ids []int) (map[int]reflect.Value, error) {
+	t, ok := modelTypes[end]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownEndpoint, "cannot get objects from '%s' endpoint", end)
+	}
+
+	if _, ok := t.FieldByName("ID"); !ok {
+		return nil, errors.Wrapf(ErrUnsupportedType, "cannot get objects without IDs from '%s' endpoint", end)
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	ids = uniqueIDs(ids)
+	found := make(map[int]reflect.Value, len(ids))
+
+	for start := 0; start < len(ids); start += maxLimit {
+		stop := start + maxLimit
+		if stop > len(ids) {
+			stop = len(ids)
+		}
+		chunk := ids[start:stop]
+
+		res := reflect.New(reflect.SliceOf(reflect.PtrTo(t)))
+		err := c.post(end, res.Interface(),
+			SetFields("*"),
+			SetLimit(len(chunk)),
+			SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(chunk)...),
+		)
+		if errors.Cause(err) == ErrNoResults {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get objects from '%s' endpoint with IDs %v", end, chunk)
+		}
+
+		for i := 0; i < res.Elem().Len(); i++ {
+			obj := res.Elem().Index(i)
+			if obj.IsNil() {
+				continue
+			}
+			found[int(obj.Elem().FieldByName("ID").Int())] = obj
+		}
+	}
+
+	return found, nil
+}
+
+// uniqueIDs returns the provided IDs in ascending order without duplicates or zeroes.
+func uniqueIDs(ids []int) []int {
+	sorted := make([]int, len(ids))
+	copy(sorted, ids)
+	sort.Ints(sorted)
+
+	var uniq []int
+	for i, id := range sorted {
+		if id == 0 || (i > 0 && id == sorted[i-1]) {
+			continue
+		}
+		uniq = append(uniq, id)
+	}
+
+	return uniq
+}
+
+// fieldByTag returns the field with the provided JSON tag from the struct
+// pointed to by v. If no field has the tag, the zero Value is returned.
+func fieldByTag(v reflect.Value, tag string) reflect.Value {
+	v = reflect.Indirect(v)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if strings.Split(t.Field(i).Tag.Get("json"), ",")[0] == tag {
+			return v.Field(i)
+		}
+	}
+
+	return reflect.Value{}
+}
+
+// referencedIDs returns the nonzero IDs held by the provided reference field,
+// which must either be a single int ID or a slice of int IDs.
+func referencedIDs(v reflect.Value) []int {
+	var ids []int
+	switch v.Kind() {
+	case reflect.Int:
+		if v.Int() != 0 {
+			ids = append(ids, int(v.Int()))
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if id := int(v.Index(i).Int()); id != 0 {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids
+}
+
+// setReferences sets the provided destination field to the objects found for
+// the provided IDs. A pointer field is set to the object of the first ID, and
+// a slice field is set to the objects of every ID in order. IDs without a
+// found object are skipped.
+func setReferences(dst reflect.Value, ids []int, found map[int]reflect.Value) {
+	switch dst.Kind() {
+	case reflect.Ptr:
+		for _, id := range ids {
+			if obj, ok := found[id]; ok {
+				dst.Set(obj)
+				return
+			}
+		}
+	case reflect.Slice:
+		objs := reflect.MakeSlice(dst.Type(), 0, len(ids))
+		for _, id := range ids {
+			if obj, ok := found[id]; ok {
+				objs = reflect.Append(objs, obj)
+			}
+		}
+		dst.Set(objs)
+	}
+}
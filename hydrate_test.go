@@ -0,0 +1,168 @@
+package igdb
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestGameService_Hydrate(t *testing.T) {
+	routes := map[string]string{
+		"/genres/": `[{"id": 5, "name": "Shooter"}, {"id": 12, "name": "Role-playing (RPG)"}]`,
+		"/covers/": `[{"id": 80, "image_id": "co2mjs"}]`,
+		"/games/":  `[{"id": 1000, "name": "Base Game"}]`,
+	}
+
+	games := []*Game{
+		{ID: 1, Genres: []int{12, 5}, Cover: 80, ParentGame: 1000},
+		nil,
+		{ID: 2, Genres: []int{12, 999}, Cover: 81},
+	}
+
+	ts, c := testServerRoutes(routes)
+	defer ts.Close()
+
+	hyd, err := c.Games.Hydrate(games, HydrateGenres, HydrateCover, HydrateParentGame, HydrateGenres)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(hyd) != 2 {
+		t.Fatalf("got: <%v> games, want: <%v> games", len(hyd), 2)
+	}
+
+	if hyd[0].Game != games[0] || hyd[1].Game != games[2] {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", hyd[0].Game, hyd[1].Game, games[0], games[2])
+	}
+
+	var names []string
+	for _, g := range hyd[0].Genres {
+		names = append(names, g.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"Role-playing (RPG)", "Shooter"}) {
+		t.Errorf("got: <%v>, want: <%v>", names, []string{"Role-playing (RPG)", "Shooter"})
+	}
+
+	if len(hyd[1].Genres) != 1 || hyd[1].Genres[0].ID != 12 {
+		t.Errorf("got: <%v>, want: <genre 12 only>", hyd[1].Genres)
+	}
+
+	if hyd[0].Cover == nil || hyd[0].Cover.ImageID != "co2mjs" {
+		t.Errorf("got: <%v>, want: <cover 80>", hyd[0].Cover)
+	}
+
+	if hyd[1].Cover != nil {
+		t.Errorf("got: <%v>, want: <nil>", hyd[1].Cover)
+	}
+
+	if hyd[0].ParentGame == nil || hyd[0].ParentGame.Name != "Base Game" {
+		t.Errorf("got: <%v>, want: <game 1000>", hyd[0].ParentGame)
+	}
+
+	if hyd[0].Themes != nil {
+		t.Errorf("got: <%v>, want: <nil>", hyd[0].Themes)
+	}
+}
+
+func TestGameService_HydrateErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		rels    []GameRelation
+		wantErr error
+	}{
+		{"Unknown relation", http.StatusOK, "[]", []GameRelation{"summary"}, ErrUnknownRelation},
+		{"No results", http.StatusOK, "[]", []GameRelation{HydrateGenres}, nil},
+		{"Empty response", http.StatusOK, "", []GameRelation{HydrateGenres}, errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", []GameRelation{HydrateGenres}, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(test.status, test.resp)
+			defer ts.Close()
+
+			_, err := c.Games.Hydrate([]*Game{{ID: 1, Genres: []int{5}}}, test.rels...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_GetByIDs(t *testing.T) {
+	var reqs int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&reqs, 1)
+		b, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(b), "fields *") || !strings.Contains(string(b), "limit ") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		io.WriteString(w, `[{"id": 1, "name": "Action"}]`)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	ids := make([]int, maxLimit+1)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	found, err := c.getByIDs(EndpointGenre, append(ids, ids...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if reqs != 2 {
+		t.Errorf("got: <%v> requests, want: <%v> requests", reqs, 2)
+	}
+
+	if g, ok := found[1].Interface().(*Genre); !ok || g.Name != "Action" {
+		t.Errorf("got: <%v>, want: <genre 1>", found[1])
+	}
+
+	if _, err = c.getByIDs(EndpointTitle, ids); errors.Cause(err) != ErrUnknownEndpoint {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrUnknownEndpoint)
+	}
+
+	if _, err = c.getByIDs(EndpointGenre, []int{-1}); errors.Cause(err) != ErrNegativeID {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrNegativeID)
+	}
+
+	if _, err = c.getByIDs(EndpointSearch, ids); errors.Cause(err) != ErrUnsupportedType {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrUnsupportedType)
+	}
+}
+
+func TestUniqueIDs(t *testing.T) {
+	var tests = []struct {
+		name    string
+		ids     []int
+		wantIDs []int
+	}{
+		{"Zero IDs", nil, nil},
+		{"Unique IDs", []int{3, 1, 2}, []int{1, 2, 3}},
+		{"Duplicate IDs", []int{3, 1, 3, 1}, []int{1, 3}},
+		{"Zero value IDs", []int{0, 5, 0}, []int{5}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids := uniqueIDs(test.ids)
+			if !reflect.DeepEqual(ids, test.wantIDs) {
+				t.Errorf("got: <%v>, want: <%v>", ids, test.wantIDs)
+			}
+		})
+	}
+}
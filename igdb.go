@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 
 	"github.com/Henry-Sarabia/apicalypse"
 	"github.com/pkg/errors"
@@ -31,22 +32,41 @@ type Client struct {
 
 	// Services
 	AgeRatings                  *AgeRatingService
+	AgeRatingCategories         *AgeRatingCategoryLookupService
 	AgeRatingContents           *AgeRatingContentService
+	AgeRatingOrganizations      *AgeRatingOrganizationLookupService
 	AlternativeNames            *AlternativeNameService
 	Artworks                    *ArtworkService
 	Characters                  *CharacterService
+	CharacterGenders            *CharacterGenderLookupService
 	CharacterMugshots           *CharacterMugshotService
+	CharacterSpecies            *CharacterSpeciesLookupService
 	Collections                 *CollectionService
+	CollectionMemberships       *CollectionMembershipService
+	CollectionMembershipTypes   *CollectionMembershipTypeService
+	CollectionRelations         *CollectionRelationService
+	CollectionRelationTypes     *CollectionRelationTypeService
+	CollectionTypes             *CollectionTypeService
 	Companies                   *CompanyService
 	CompanyLogos                *CompanyLogoService
 	CompanyWebsites             *CompanyWebsiteService
 	Covers                      *CoverService
+	DateFormats                 *DateFormatLookupService
+	Dumps                       *DumpService
+	Events                      *EventService
+	EventLogos                  *EventLogoService
+	EventNetworks               *EventNetworkService
 	ExternalGames               *ExternalGameService
+	ExternalGameSources         *ExternalGameSourceLookupService
 	Franchises                  *FranchiseService
 	Games                       *GameService
 	GameEngines                 *GameEngineService
 	GameEngineLogos             *GameEngineLogoService
+	GameLocalizations           *GameLocalizationService
 	GameModes                   *GameModeService
+	GameStatuses                *GameStatusLookupService
+	GameTimeToBeats             *GameTimeToBeatService
+	GameTypes                   *GameTypeLookupService
 	GameVersions                *GameVersionService
 	GameVersionFeatures         *GameVersionFeatureService
 	GameVersionFeatureValues    *GameVersionFeatureValueService
@@ -54,19 +74,30 @@ type Client struct {
 	Genres                      *GenreService
 	InvolvedCompanies           *InvolvedCompanyService
 	Keywords                    *KeywordService
+	Languages                   *LanguageService
+	LanguageSupports            *LanguageSupportService
+	LanguageSupportTypes        *LanguageSupportTypeService
 	MultiplayerModes            *MultiplayerModeService
+	NetworkTypes                *NetworkTypeService
 	Platforms                   *PlatformService
 	PlatformLogos               *PlatformLogoService
+	PlatformTypes               *PlatformTypeLookupService
 	PlatformVersions            *PlatformVersionService
 	PlatformVersionCompanies    *PlatformVersionCompanyService
 	PlatformVersionReleaseDates *PlatformVersionReleaseDateService
 	PlatformWebsites            *PlatformWebsiteService
 	PlayerPerspectives          *PlayerPerspectiveService
 	PlatformFamilies            *PlatformFamilyService
+	PopularityPrimitives        *PopularityPrimitiveService
+	PopularityTypes             *PopularityTypeService
+	Regions                     *RegionService
 	ReleaseDates                *ReleaseDateService
+	ReleaseDateStatuses         *ReleaseDateStatusLookupService
 	Screenshots                 *ScreenshotService
 	Themes                      *ThemeService
+	Webhooks                    *WebhookService
 	Websites                    *WebsiteService
+	WebsiteTypes                *WebsiteTypeLookupService
 }
 
 // NewClient returns a new Client configured to communicate with the IGDB.
@@ -88,22 +119,41 @@ func NewClient(clientID string, appAccessToken string, custom *http.Client) *Cli
 	}
 
 	c.AgeRatings = &AgeRatingService{client: c, end: EndpointAgeRating}
+	c.AgeRatingCategories = &AgeRatingCategoryLookupService{client: c, end: EndpointAgeRatingCategory}
 	c.AgeRatingContents = &AgeRatingContentService{client: c, end: EndpointAgeRatingContent}
+	c.AgeRatingOrganizations = &AgeRatingOrganizationLookupService{client: c, end: EndpointAgeRatingOrganization}
 	c.AlternativeNames = &AlternativeNameService{client: c, end: EndpointAlternativeName}
 	c.Artworks = &ArtworkService{client: c, end: EndpointArtwork}
 	c.Characters = &CharacterService{client: c, end: EndpointCharacter}
+	c.CharacterGenders = &CharacterGenderLookupService{client: c, end: EndpointCharacterGender}
 	c.CharacterMugshots = &CharacterMugshotService{client: c, end: EndpointCharacterMugshot}
+	c.CharacterSpecies = &CharacterSpeciesLookupService{client: c, end: EndpointCharacterSpecies}
 	c.Collections = &CollectionService{client: c, end: EndpointCollection}
+	c.CollectionMemberships = &CollectionMembershipService{client: c, end: EndpointCollectionMembership}
+	c.CollectionMembershipTypes = &CollectionMembershipTypeService{client: c, end: EndpointCollectionMembershipType}
+	c.CollectionRelations = &CollectionRelationService{client: c, end: EndpointCollectionRelation}
+	c.CollectionRelationTypes = &CollectionRelationTypeService{client: c, end: EndpointCollectionRelationType}
+	c.CollectionTypes = &CollectionTypeService{client: c, end: EndpointCollectionType}
 	c.Companies = &CompanyService{client: c, end: EndpointCompany}
 	c.CompanyLogos = &CompanyLogoService{client: c, end: EndpointCompanyLogo}
 	c.CompanyWebsites = &CompanyWebsiteService{client: c, end: EndpointCompanyWebsite}
 	c.Covers = &CoverService{client: c, end: EndpointCover}
+	c.DateFormats = &DateFormatLookupService{client: c, end: EndpointDateFormat}
+	c.Dumps = &DumpService{client: c, end: endpointDump}
+	c.Events = &EventService{client: c, end: EndpointEvent}
+	c.EventLogos = &EventLogoService{client: c, end: EndpointEventLogo}
+	c.EventNetworks = &EventNetworkService{client: c, end: EndpointEventNetwork}
 	c.ExternalGames = &ExternalGameService{client: c, end: EndpointExternalGame}
+	c.ExternalGameSources = &ExternalGameSourceLookupService{client: c, end: EndpointExternalGameSource}
 	c.Franchises = &FranchiseService{client: c, end: EndpointFranchise}
 	c.Games = &GameService{client: c, end: EndpointGame}
 	c.GameEngines = &GameEngineService{client: c, end: EndpointGameEngine}
 	c.GameEngineLogos = &GameEngineLogoService{client: c, end: EndpointGameEngineLogo}
+	c.GameLocalizations = &GameLocalizationService{client: c, end: EndpointGameLocalization}
 	c.GameModes = &GameModeService{client: c, end: EndpointGameMode}
+	c.GameStatuses = &GameStatusLookupService{client: c, end: EndpointGameStatus}
+	c.GameTimeToBeats = &GameTimeToBeatService{client: c, end: EndpointGameTimeToBeat}
+	c.GameTypes = &GameTypeLookupService{client: c, end: EndpointGameType}
 	c.GameVersions = &GameVersionService{client: c, end: EndpointGameVersion}
 	c.GameVersionFeatures = &GameVersionFeatureService{client: c, end: EndpointGameVersionFeature}
 	c.GameVersionFeatureValues = &GameVersionFeatureValueService{client: c, end: EndpointGameVersionFeatureValue}
@@ -111,19 +161,30 @@ func NewClient(clientID string, appAccessToken string, custom *http.Client) *Cli
 	c.Genres = &GenreService{client: c, end: EndpointGenre}
 	c.InvolvedCompanies = &InvolvedCompanyService{client: c, end: EndpointInvolvedCompany}
 	c.Keywords = &KeywordService{client: c, end: EndpointKeyword}
+	c.Languages = &LanguageService{client: c, end: EndpointLanguage}
+	c.LanguageSupports = &LanguageSupportService{client: c, end: EndpointLanguageSupport}
+	c.LanguageSupportTypes = &LanguageSupportTypeService{client: c, end: EndpointLanguageSupportType}
 	c.MultiplayerModes = &MultiplayerModeService{client: c, end: EndpointMultiplayerMode}
+	c.NetworkTypes = &NetworkTypeService{client: c, end: EndpointNetworkType}
 	c.Platforms = &PlatformService{client: c, end: EndpointPlatform}
 	c.PlatformLogos = &PlatformLogoService{client: c, end: EndpointPlatformLogo}
+	c.PlatformTypes = &PlatformTypeLookupService{client: c, end: EndpointPlatformType}
 	c.PlatformVersions = &PlatformVersionService{client: c, end: EndpointPlatformVersion}
 	c.PlatformVersionCompanies = &PlatformVersionCompanyService{client: c, end: EndpointPlatformVersionCompany}
 	c.PlatformVersionReleaseDates = &PlatformVersionReleaseDateService{client: c, end: EndpointPlatformVersionReleaseDate}
 	c.PlatformWebsites = &PlatformWebsiteService{client: c, end: EndpointPlatformWebsite}
 	c.PlayerPerspectives = &PlayerPerspectiveService{client: c, end: EndpointPlayerPerspective}
 	c.PlatformFamilies = &PlatformFamilyService{client: c, end: EndpointPlatformFamily}
+	c.PopularityPrimitives = &PopularityPrimitiveService{client: c, end: EndpointPopularityPrimitive}
+	c.PopularityTypes = &PopularityTypeService{client: c, end: EndpointPopularityType}
+	c.Regions = &RegionService{client: c, end: EndpointRegion}
 	c.ReleaseDates = &ReleaseDateService{client: c, end: EndpointReleaseDate}
+	c.ReleaseDateStatuses = &ReleaseDateStatusLookupService{client: c, end: EndpointReleaseDateStatus}
 	c.Screenshots = &ScreenshotService{client: c, end: EndpointScreenshot}
 	c.Themes = &ThemeService{client: c, end: EndpointTheme}
+	c.Webhooks = &WebhookService{client: c, end: endpointWebhook}
 	c.Websites = &WebsiteService{client: c, end: EndpointWebsite}
+	c.WebsiteTypes = &WebsiteTypeLookupService{client: c, end: EndpointWebsiteType}
 
 	return c
 }
@@ -131,22 +192,36 @@ func NewClient(clientID string, appAccessToken string, custom *http.Client) *Cli
 // Request configures a new request for the provided URL and
 // adds the necessary headers to communicate with the IGDB.
 func (c *Client) request(end endpoint, opts ...Option) (*http.Request, error) {
+	if isRemoved(end) {
+		return nil, errors.Wrapf(ErrRemovedEndpoint, "cannot make request for '%s' endpoint", end)
+	}
+
 	unwrapped, err := unwrapOptions(opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create request with invalid options")
 	}
 
+	if err = lintOptions(end, unwrapped...); err != nil {
+		return nil, errors.Wrap(err, "cannot create request with conflicting options")
+	}
+
 	req, err := apicalypse.NewRequest("POST", c.rootURL+string(end), unwrapped...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot make request for '%s' endpoint", end)
 	}
 
+	c.setHeaders(req)
+
+	return req, nil
+}
+
+// setHeaders adds the headers necessary to
+// communicate with the IGDB to the provided request.
+func (c *Client) setHeaders(req *http.Request) {
 	req.Header.Add("client-id", c.clientID)
 	req.Header.Add("Authorization", "Bearer "+c.token)
 	req.Header.Add("x-user-agent", "HenrySarabia/igdb")
 	req.Header.Add("Accept", "application/json")
-
-	return req, nil
 }
 
 // Send sends the provided request and stores the response in the value pointed to by result.
@@ -194,3 +269,25 @@ func (c *Client) post(end endpoint, result interface{}, opts ...Option) error {
 
 	return nil
 }
+
+// postAll sends as many POST requests to the provided endpoint with the provided
+// options as it takes to page through every result, and appends the results to
+// the slice pointed to by result. The options must not set a limit or offset.
+func (c *Client) postAll(end endpoint, result interface{}, opts ...Option) error {
+	all := reflect.ValueOf(result).Elem()
+	for off := 0; ; off += maxLimit {
+		page := reflect.New(all.Type())
+		err := c.post(end, page.Interface(), append(opts, SetLimit(maxLimit), SetOffset(off))...)
+		if errors.Cause(err) == ErrNoResults {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		all.Set(reflect.AppendSlice(all, page.Elem()))
+		if page.Elem().Len() < maxLimit {
+			return nil
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package igdb
 
 import (
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -21,6 +23,8 @@ func TestClient_Request(t *testing.T) {
 		{"Zero options", testEndpoint, nil, httptest.NewRequest("POST", igdbURL+testEndpoint, nil), nil},
 		{"Single option", testEndpoint, []Option{SetLimit(15)}, httptest.NewRequest("POST", igdbURL+testEndpoint, strings.NewReader("limit 15; ")), nil},
 		{"Error option", testEndpoint, []Option{SetLimit(-99)}, httptest.NewRequest("POST", igdbURL+testEndpoint, nil), ErrOutOfRange},
+		{"Removed endpoint", EndpointPulse, nil, nil, ErrRemovedEndpoint},
+		{"Removed count endpoint", EndpointTitle + suffixCount, nil, nil, ErrRemovedEndpoint},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -606,3 +610,45 @@ func TestClient_Post(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_PostAll(t *testing.T) {
+	page := "[" + strings.Repeat(`{"id": 1},`, maxLimit-1) + `{"id": 1}]`
+
+	var reqs int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs++
+		b, _ := ioutil.ReadAll(r.Body)
+		switch {
+		case strings.Contains(string(b), "offset 0"):
+			io.WriteString(w, page)
+		case strings.Contains(string(b), "offset 500"):
+			io.WriteString(w, `[{"id": 2}]`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	var g []*Genre
+	if err := c.postAll(EndpointGenre, &g, SetFields("id")); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(g) != maxLimit+1 || g[maxLimit].ID != 2 {
+		t.Errorf("got: <%v> results, want: <%v> results", len(g), maxLimit+1)
+	}
+
+	if reqs != 2 {
+		t.Errorf("got: <%v> requests, want: <%v> requests", reqs, 2)
+	}
+
+	ts2, c := testServerRepeat(http.StatusBadRequest, "")
+	defer ts2.Close()
+
+	if err := c.postAll(EndpointGenre, &g); errors.Cause(err) != ErrBadRequest {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrBadRequest)
+	}
+}
@@ -12,15 +12,24 @@ import (
 // of a particular video game.
 // For more information visit: https://api-docs.igdb.com/#involved-company
 type InvolvedCompany struct {
-	ID         int  `json:"id"`
-	Company    int  `json:"company"`
-	CreatedAt  int  `json:"created_at"`
-	Developer  bool `json:"developer"`
-	Game       int  `json:"game"`
-	Porting    bool `json:"porting"`
-	Publisher  bool `json:"publisher"`
-	Supporting bool `json:"supporting"`
-	UpdatedAt  int  `json:"updated_at"`
+	Presence
+	Extras
+	ID         int       `json:"id"`
+	Company    int       `json:"company"`
+	CreatedAt  Timestamp `json:"created_at"`
+	Developer  bool      `json:"developer"`
+	Game       int       `json:"game"`
+	Porting    bool      `json:"porting"`
+	Publisher  bool      `json:"publisher"`
+	Supporting bool      `json:"supporting"`
+	UpdatedAt  Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the InvolvedCompany and
+// records which of its fields were present or unrecognized.
+func (i *InvolvedCompany) UnmarshalJSON(b []byte) error {
+	type involvedCompany InvolvedCompany
+	return decodeModel(b, (*involvedCompany)(i), &i.Presence, &i.Extras)
 }
 
 // InvolvedCompanyService handles all the API calls for the IGDB InvolvedCompany endpoint.
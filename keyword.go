@@ -13,11 +13,21 @@ import (
 // such as "World War 2" or "Steampunk".
 // For more information visit: https://api-docs.igdb.com/#keyword
 type Keyword struct {
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	Url       string `json:"url"`
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	Url       string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the Keyword and
+// records which of its fields were present or unrecognized.
+func (k *Keyword) UnmarshalJSON(b []byte) error {
+	type keyword Keyword
+	return decodeModel(b, (*keyword)(k), &k.Presence, &k.Extras)
 }
 
 // KeywordService handles all the API calls for the IGDB Keyword endpoint.
@@ -42,6 +52,25 @@ func (ks *KeywordService) Get(id int, opts ...Option) (*Keyword, error) {
 	return key[0], nil
 }
 
+// GetBySlug returns a single Keyword identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Keywords, an error is returned.
+func (ks *KeywordService) GetBySlug(slug string, opts ...Option) (*Keyword, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var key []*Keyword
+
+	opts = append(opts, setSlug(slug))
+	err := ks.client.post(ks.end, &key, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Keyword with slug %s", slug)
+	}
+
+	return key[0], nil
+}
+
 // List returns a list of Keywords identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Keyword is ignored. If none of the IDs
@@ -82,6 +111,21 @@ func (ks *KeywordService) Index(opts ...Option) ([]*Keyword, error) {
 	return key, nil
 }
 
+// Search returns a list of Keywords found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no Keywords are found using the provided query, an error is returned.
+func (ks *KeywordService) Search(qry string, opts ...Option) ([]*Keyword, error) {
+	var key []*Keyword
+
+	opts = append(opts, setSearch(qry))
+	err := ks.client.post(ks.end, &key, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Keyword with query %s", qry)
+	}
+
+	return key, nil
+}
+
 // Count returns the number of Keywords available in the IGDB.
 // Provide the SetFilter functional option if you need to filter
 // which Keywords to count.
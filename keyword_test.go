@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	testKeywordGet  string = "test_data/keyword_get.json"
-	testKeywordList string = "test_data/keyword_list.json"
+	testKeywordGet    string = "test_data/keyword_get.json"
+	testKeywordList   string = "test_data/keyword_list.json"
+	testKeywordSearch string = "test_data/keyword_search.json"
 )
 
 func TestKeywordService_Get(t *testing.T) {
@@ -60,6 +61,52 @@ func TestKeywordService_Get(t *testing.T) {
 	}
 }
 
+func TestKeywordService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testKeywordGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Keyword, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name        string
+		file        string
+		slug        string
+		opts        []Option
+		wantKeyword *Keyword
+		wantErr     error
+	}{
+		{"Valid response", testKeywordGet, "fight-trivia", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "fight-trivia", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "fight-trivia", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			key, err := c.Keywords.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(key, test.wantKeyword) {
+				t.Errorf("got: <%v>, \nwant: <%v>", key, test.wantKeyword)
+			}
+		})
+	}
+}
+
 func TestKeywordService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testKeywordList)
 	if err != nil {
@@ -151,6 +198,52 @@ func TestKeywordService_Index(t *testing.T) {
 	}
 }
 
+func TestKeywordService_Search(t *testing.T) {
+	f, err := ioutil.ReadFile(testKeywordSearch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Keyword, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name         string
+		file         string
+		qry          string
+		opts         []Option
+		wantKeywords []*Keyword
+		wantErr      error
+	}{
+		{"Valid response", testKeywordSearch, "music", []Option{SetLimit(50)}, init, nil},
+		{"Empty query", testFileEmpty, "", []Option{SetLimit(50)}, nil, ErrEmptyQry},
+		{"Empty response", testFileEmpty, "music", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "music", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent entry", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			key, err := c.Keywords.Search(test.qry, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(key, test.wantKeywords) {
+				t.Errorf("got: <%v>, \nwant: <%v>", key, test.wantKeywords)
+			}
+		})
+	}
+}
+
 func TestKeywordService_Count(t *testing.T) {
 	var tests = []struct {
 		name      string
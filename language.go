@@ -0,0 +1,115 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct Language -add-tags json -w
+
+// Language represents a language along with its locale and native name.
+// For more information visit: https://api-docs.igdb.com/#language
+type Language struct {
+	Presence
+	Extras
+	ID         int       `json:"id"`
+	Checksum   string    `json:"checksum"`
+	CreatedAt  Timestamp `json:"created_at"`
+	Locale     string    `json:"locale"`
+	Name       string    `json:"name"`
+	NativeName string    `json:"native_name"`
+	UpdatedAt  Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the Language and
+// records which of its fields were present or unrecognized.
+func (l *Language) UnmarshalJSON(b []byte) error {
+	type language Language
+	return decodeModel(b, (*language)(l), &l.Presence, &l.Extras)
+}
+
+// LanguageService handles all the API calls for the IGDB Language endpoint.
+type LanguageService service
+
+// Get returns a single Language identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any Languages, an error is returned.
+func (ls *LanguageService) Get(id int, opts ...Option) (*Language, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var lang []*Language
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ls.client.post(ls.end, &lang, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Language with ID %v", id)
+	}
+
+	return lang[0], nil
+}
+
+// List returns a list of Languages identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a Language is ignored. If none of the IDs
+// match a Language, an error is returned.
+func (ls *LanguageService) List(ids []int, opts ...Option) ([]*Language, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var lang []*Language
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ls.client.post(ls.end, &lang, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Languages with IDs %v", ids)
+	}
+
+	return lang, nil
+}
+
+// Index returns an index of Languages based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no Languages can
+// be found using the provided options, an error is returned.
+func (ls *LanguageService) Index(opts ...Option) ([]*Language, error) {
+	var lang []*Language
+
+	err := ls.client.post(ls.end, &lang, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of Languages")
+	}
+
+	return lang, nil
+}
+
+// Count returns the number of Languages available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which Languages to count.
+func (ls *LanguageService) Count(opts ...Option) (int, error) {
+	ct, err := ls.client.getCount(ls.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count Languages")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB Language object.
+func (ls *LanguageService) Fields() ([]string, error) {
+	f, err := ls.client.getFields(ls.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get Language fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,171 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"sort"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct LanguageSupport -add-tags json -w
+
+// LanguageSupport represents the support a particular game has for a
+// particular language, such as audio, subtitles, or interface support.
+// For more information visit: https://api-docs.igdb.com/#language-support
+type LanguageSupport struct {
+	Presence
+	Extras
+	ID                  int       `json:"id"`
+	Checksum            string    `json:"checksum"`
+	CreatedAt           Timestamp `json:"created_at"`
+	Game                int       `json:"game"`
+	Language            int       `json:"language"`
+	LanguageSupportType int       `json:"language_support_type"`
+	UpdatedAt           Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the LanguageSupport and
+// records which of its fields were present or unrecognized.
+func (l *LanguageSupport) UnmarshalJSON(b []byte) error {
+	type languageSupport LanguageSupport
+	return decodeModel(b, (*languageSupport)(l), &l.Presence, &l.Extras)
+}
+
+// LanguageSupportService handles all the API calls for the IGDB LanguageSupport endpoint.
+type LanguageSupportService service
+
+// Get returns a single LanguageSupport identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any LanguageSupports, an error is returned.
+func (ls *LanguageSupportService) Get(id int, opts ...Option) (*LanguageSupport, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var sup []*LanguageSupport
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ls.client.post(ls.end, &sup, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get LanguageSupport with ID %v", id)
+	}
+
+	return sup[0], nil
+}
+
+// List returns a list of LanguageSupports identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a LanguageSupport is ignored. If none of the IDs
+// match a LanguageSupport, an error is returned.
+func (ls *LanguageSupportService) List(ids []int, opts ...Option) ([]*LanguageSupport, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var sup []*LanguageSupport
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ls.client.post(ls.end, &sup, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get LanguageSupports with IDs %v", ids)
+	}
+
+	return sup, nil
+}
+
+// Index returns an index of LanguageSupports based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no LanguageSupports can
+// be found using the provided options, an error is returned.
+func (ls *LanguageSupportService) Index(opts ...Option) ([]*LanguageSupport, error) {
+	var sup []*LanguageSupport
+
+	err := ls.client.post(ls.end, &sup, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of LanguageSupports")
+	}
+
+	return sup, nil
+}
+
+// Count returns the number of LanguageSupports available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which LanguageSupports to count.
+func (ls *LanguageSupportService) Count(opts ...Option) (int, error) {
+	ct, err := ls.client.getCount(ls.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count LanguageSupports")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB LanguageSupport object.
+func (ls *LanguageSupportService) Fields() ([]string, error) {
+	f, err := ls.client.getFields(ls.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get LanguageSupport fields")
+	}
+
+	return f, nil
+}
+
+// ErrUnknownSupportType occurs when a language support type is requested
+// that does not match the name of any LanguageSupportType in the IGDB.
+var ErrUnknownSupportType = errors.New("language support type does not exist")
+
+// Languages returns the Languages that the Game identified by the provided
+// IGDB ID supports with the LanguageSupportType of the provided name, such
+// as "Audio", "Subtitles", or "Interface". The name is matched regardless of
+// case. The Languages are sorted by name. If the Game has no support of the
+// provided type, an error is returned.
+func (ls *LanguageSupportService) Languages(game int, supportType string) ([]*Language, error) {
+	if game < 0 {
+		return nil, ErrNegativeID
+	}
+
+	typ, err := ls.client.LanguageSupportTypes.byName(supportType)
+	if err != nil {
+		return nil, err
+	}
+
+	var sup []*LanguageSupport
+	err = ls.client.postAll(ls.end, &sup,
+		SetFields("language"),
+		SetFilter("game", OpEquals, strconv.Itoa(game)),
+		SetFilter("language_support_type", OpEquals, strconv.Itoa(typ.ID)),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get %s LanguageSupports of Game with ID %v", typ.Name, game)
+	}
+
+	ids := make([]int, 0, len(sup))
+	for _, s := range sup {
+		ids = append(ids, s.Language)
+	}
+
+	found, err := ls.client.getByIDs(EndpointLanguage, ids)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get %s Languages of Game with ID %v", typ.Name, game)
+	}
+
+	if len(found) == 0 {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get %s Languages of Game with ID %v", typ.Name, game)
+	}
+
+	lang := make([]*Language, 0, len(found))
+	for _, obj := range found {
+		lang = append(lang, obj.Interface().(*Language))
+	}
+	sort.Slice(lang, func(i, j int) bool {
+		return lang[i].Name < lang[j].Name
+	})
+
+	return lang, nil
+}
@@ -0,0 +1,261 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	testLanguageSupportGet  string = "test_data/languagesupport_get.json"
+	testLanguageSupportList string = "test_data/languagesupport_list.json"
+)
+
+func TestLanguageSupportService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testLanguageSupportGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*LanguageSupport, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                string
+		file                string
+		id                  int
+		opts                []Option
+		wantLanguageSupport *LanguageSupport
+		wantErr             error
+	}{
+		{"Valid response", testLanguageSupportGet, 76412, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 76412, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 76412, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			sup, err := c.LanguageSupports.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(sup, test.wantLanguageSupport) {
+				t.Errorf("got: <%v>, \nwant: <%v>", sup, test.wantLanguageSupport)
+			}
+		})
+	}
+}
+
+func TestLanguageSupportService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testLanguageSupportList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*LanguageSupport, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                 string
+		file                 string
+		ids                  []int
+		opts                 []Option
+		wantLanguageSupports []*LanguageSupport
+		wantErr              error
+	}{
+		{"Valid response", testLanguageSupportList, []int{76412, 76413, 76414, 76415, 76416}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{76412, 76413, 76414, 76415, 76416}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{76412, 76413, 76414, 76415, 76416}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			sup, err := c.LanguageSupports.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(sup, test.wantLanguageSupports) {
+				t.Errorf("got: <%v>, \nwant: <%v>", sup, test.wantLanguageSupports)
+			}
+		})
+	}
+}
+
+func TestLanguageSupportService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testLanguageSupportList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*LanguageSupport, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                 string
+		file                 string
+		opts                 []Option
+		wantLanguageSupports []*LanguageSupport
+		wantErr              error
+	}{
+		{"Valid response", testLanguageSupportList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			sup, err := c.LanguageSupports.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(sup, test.wantLanguageSupports) {
+				t.Errorf("got: <%v>, \nwant: <%v>", sup, test.wantLanguageSupports)
+			}
+		})
+	}
+}
+
+func TestLanguageSupportService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.LanguageSupports.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestLanguageSupportService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.LanguageSupports.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
+
+func TestLanguageSupportService_Languages(t *testing.T) {
+	types, err := ioutil.ReadFile(testLanguageSupportTypeList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		supports  string
+		id        int
+		typ       string
+		wantNames []string
+		wantErr   error
+	}{
+		{"Valid response", `[{"id": 2, "language": 9}, {"id": 1, "language": 7}]`, 7346, "subtitles", []string{"English", "French"}, nil},
+		{"Invalid ID", "[]", -1, "Subtitles", nil, ErrNegativeID},
+		{"Empty type", "[]", 7346, " ", nil, ErrEmptyQry},
+		{"Unknown type", "[]", 7346, "Smell-O-Vision", nil, ErrUnknownSupportType},
+		{"No results", "[]", 7346, "Audio", nil, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRoutes(map[string]string{
+				"/language_support_types/": string(types),
+				"/language_supports/":      test.supports,
+				"/languages/":              `[{"id": 9, "name": "French"}, {"id": 7, "name": "English"}]`,
+			})
+			defer ts.Close()
+
+			lang, err := c.LanguageSupports.Languages(test.id, test.typ)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			var names []string
+			for _, l := range lang {
+				names = append(names, l.Name)
+			}
+
+			if !reflect.DeepEqual(names, test.wantNames) {
+				t.Errorf("got: <%v>, want: <%v>", names, test.wantNames)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/blank"
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct LanguageSupportType -add-tags json -w
+
+// LanguageSupportType represents a kind of language support such as
+// audio, subtitles, or interface.
+// For more information visit: https://api-docs.igdb.com/#language-support-type
+type LanguageSupportType struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the LanguageSupportType and
+// records which of its fields were present or unrecognized.
+func (l *LanguageSupportType) UnmarshalJSON(b []byte) error {
+	type languageSupportType LanguageSupportType
+	return decodeModel(b, (*languageSupportType)(l), &l.Presence, &l.Extras)
+}
+
+// LanguageSupportTypeService handles all the API calls for the IGDB LanguageSupportType endpoint.
+type LanguageSupportTypeService service
+
+// Get returns a single LanguageSupportType identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any LanguageSupportTypes, an error is returned.
+func (ls *LanguageSupportTypeService) Get(id int, opts ...Option) (*LanguageSupportType, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var typ []*LanguageSupportType
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ls.client.post(ls.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get LanguageSupportType with ID %v", id)
+	}
+
+	return typ[0], nil
+}
+
+// List returns a list of LanguageSupportTypes identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a LanguageSupportType is ignored. If none of the IDs
+// match a LanguageSupportType, an error is returned.
+func (ls *LanguageSupportTypeService) List(ids []int, opts ...Option) ([]*LanguageSupportType, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var typ []*LanguageSupportType
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ls.client.post(ls.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get LanguageSupportTypes with IDs %v", ids)
+	}
+
+	return typ, nil
+}
+
+// Index returns an index of LanguageSupportTypes based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no LanguageSupportTypes can
+// be found using the provided options, an error is returned.
+func (ls *LanguageSupportTypeService) Index(opts ...Option) ([]*LanguageSupportType, error) {
+	var typ []*LanguageSupportType
+
+	err := ls.client.post(ls.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of LanguageSupportTypes")
+	}
+
+	return typ, nil
+}
+
+// Count returns the number of LanguageSupportTypes available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which LanguageSupportTypes to count.
+func (ls *LanguageSupportTypeService) Count(opts ...Option) (int, error) {
+	ct, err := ls.client.getCount(ls.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count LanguageSupportTypes")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB LanguageSupportType object.
+func (ls *LanguageSupportTypeService) Fields() ([]string, error) {
+	f, err := ls.client.getFields(ls.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get LanguageSupportType fields")
+	}
+
+	return f, nil
+}
+
+// byName returns the LanguageSupportType with the provided name,
+// ignoring case. If none match, an error is returned.
+func (ls *LanguageSupportTypeService) byName(name string) (*LanguageSupportType, error) {
+	if blank.Is(name) {
+		return nil, ErrEmptyQry
+	}
+
+	var typ []*LanguageSupportType
+	err := ls.client.postAll(ls.end, &typ, SetFields("name"))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get LanguageSupportTypes")
+	}
+
+	for _, t := range typ {
+		if strings.EqualFold(strings.TrimSpace(name), t.Name) {
+			return t, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrUnknownSupportType, "cannot find LanguageSupportType '%s'", name)
+}
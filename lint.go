@@ -0,0 +1,155 @@
+package igdb
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/pkg/errors"
+)
+
+// Errors returned when linting the options of an API call.
+var (
+	// ErrDuplicateOption occurs when an option that can only be set once is set multiple times.
+	ErrDuplicateOption = errors.New("provided option cannot be set more than once")
+	// ErrConflictingFields occurs when the same field is both requested and excluded.
+	ErrConflictingFields = errors.New("one or more provided fields are both requested and excluded")
+	// ErrSortedSearch occurs when a search query is combined with an order.
+	ErrSortedSearch = errors.New("search results are ordered by relevance and cannot be sorted")
+	// ErrIgnoredOption occurs when an option is set that the endpoint ignores.
+	ErrIgnoredOption = errors.New("provided option is ignored by the endpoint")
+	// ErrLimitExceeded occurs when a limit is set above the maximum of the endpoint.
+	ErrLimitExceeded = errors.New("provided limit exceeds the maximum of the endpoint")
+)
+
+// endpointLimits maps the endpoints that cap their results below
+// the maximum limit of the API to the maximum limit they accept.
+var endpointLimits = map[endpoint]int{
+	EndpointSearch: 50,
+}
+
+// Query parameters as they are named by the apicalypse package.
+const (
+	paramFields  = "fields"
+	paramExclude = "exclude"
+	paramLimit   = "limit"
+	paramOffset  = "offset"
+	paramSort    = "sort"
+	paramSearch  = "search"
+	paramWhere   = "where"
+)
+
+// Endpoint suffixes used for counting entities and retrieving their fields.
+const (
+	suffixCount = "count"
+	suffixMeta  = "meta"
+)
+
+// LintOptions checks the provided options for combinations that the IGDB
+// rejects or silently ignores when sent to the provided endpoint. These include
+// setting an option other than SetFilter more than once, both requesting and
+// excluding the same field, sorting search results, setting a limit above the
+// maximum of the endpoint, such as the cap on search results, and setting
+// options a counting endpoint ignores. The first problem encountered is
+// returned.
+//
+// Every request made by a service is linted before it is sent, so LintOptions
+// is only needed to validate options ahead of time.
+func LintOptions(end endpoint, opts ...Option) error {
+	unwrapped, err := unwrapOptions(opts...)
+	if err != nil {
+		return err
+	}
+
+	return lintOptions(end, unwrapped...)
+}
+
+// lintOptions applies each of the provided options to its own empty query and
+// checks the parameters they set against each other and the provided endpoint.
+func lintOptions(end endpoint, opts ...apicalypse.Option) error {
+	seen := make(map[string]bool)
+	var fields, excluded []string
+	limit := -1
+
+	for _, opt := range opts {
+		if opt == nil {
+			return errors.New("cannot lint nil option")
+		}
+
+		params := make(map[string]string)
+		if err := opt(params); err != nil {
+			return errors.Wrap(err, "cannot lint invalid option")
+		}
+
+		for _, p := range sortedKeys(params) {
+			if p != paramWhere && seen[p] {
+				return errors.Wrapf(ErrDuplicateOption, "'%s' is set more than once", p)
+			}
+			seen[p] = true
+
+			switch p {
+			case paramFields:
+				fields = strings.Split(params[p], ",")
+			case paramExclude:
+				excluded = strings.Split(params[p], ",")
+			case paramLimit:
+				n, err := strconv.Atoi(params[p])
+				if err != nil {
+					return errors.Wrapf(ErrOutOfRange, "cannot lint limit '%s'", params[p])
+				}
+				limit = n
+			}
+		}
+	}
+
+	if both := intersect(fields, excluded); len(both) > 0 {
+		return errors.Wrapf(ErrConflictingFields, "fields %v", both)
+	}
+
+	if seen[paramSearch] && seen[paramSort] {
+		return ErrSortedSearch
+	}
+
+	if max, ok := endpointLimits[end]; ok && limit > max {
+		return errors.Wrapf(ErrLimitExceeded, "limit of %d is above the maximum of %d for the '%s' endpoint", limit, max, end)
+	}
+
+	if strings.HasSuffix(string(end), suffixCount) || strings.HasSuffix(string(end), suffixMeta) {
+		for _, p := range []string{paramFields, paramExclude, paramLimit, paramOffset, paramSort} {
+			if seen[p] {
+				return errors.Wrapf(ErrIgnoredOption, "'%s' has no effect on the '%s' endpoint", p, end)
+			}
+		}
+	}
+
+	return nil
+}
+
+// sortedKeys returns the keys of the provided map in ascending order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// intersect returns the strings found in both of the provided slices.
+func intersect(x, y []string) []string {
+	in := make(map[string]bool, len(x))
+	for _, s := range x {
+		in[s] = true
+	}
+
+	var both []string
+	for _, s := range y {
+		if in[s] {
+			both = append(both, s)
+		}
+	}
+
+	return both
+}
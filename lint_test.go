@@ -0,0 +1,70 @@
+package igdb
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestLintOptions(t *testing.T) {
+	var tests = []struct {
+		name    string
+		end     endpoint
+		opts    []Option
+		wantErr error
+	}{
+		{"Zero options", EndpointGame, nil, nil},
+		{"Distinct options", EndpointGame, []Option{SetLimit(5), SetOffset(10), SetFields("name"), SetOrder("hypes", OrderDescending)}, nil},
+		{"Multiple filters", EndpointGame, []Option{SetFilter("hypes", OpGreaterThan, "5"), SetFilter("rating", OpLessThan, "50")}, nil},
+		{"Asterisk fields with exclude", EndpointGame, []Option{SetFields("*"), SetExclude("summary")}, nil},
+		{"Multiple limits", EndpointGame, []Option{SetLimit(5), SetLimit(10)}, ErrDuplicateOption},
+		{"Multiple offsets", EndpointGame, []Option{SetOffset(5), SetOffset(10)}, ErrDuplicateOption},
+		{"Multiple orders", EndpointGame, []Option{SetOrder("hypes", OrderDescending), SetOrder("rating", OrderAscending)}, ErrDuplicateOption},
+		{"Multiple fields", EndpointGame, []Option{SetFields("name"), SetFields("slug")}, ErrDuplicateOption},
+		{"Duplicate inside composed option", EndpointGame, []Option{ComposeOptions(SetLimit(5)), SetLimit(10)}, ErrDuplicateOption},
+		{"Field both requested and excluded", EndpointGame, []Option{SetFields("name", "slug"), SetExclude("slug")}, ErrConflictingFields},
+		{"Search with order", EndpointGame, []Option{setSearch("zelda"), SetOrder("hypes", OrderDescending)}, ErrSortedSearch},
+		{"Search with filter", EndpointGame, []Option{setSearch("zelda"), SetFilter("hypes", OpGreaterThan, "5")}, nil},
+		{"Count with filter", EndpointGame + suffixCount, []Option{SetFilter("hypes", OpGreaterThan, "5")}, nil},
+		{"Count with limit", EndpointGame + suffixCount, []Option{SetLimit(5)}, ErrIgnoredOption},
+		{"Count with fields", EndpointGame + suffixCount, []Option{SetFields("name")}, ErrIgnoredOption},
+		{"Meta with order", EndpointGame + suffixMeta, []Option{SetOrder("hypes", OrderDescending)}, ErrIgnoredOption},
+		{"Invalid option", EndpointGame, []Option{SetLimit(-5)}, ErrOutOfRange},
+		{"Multiple limits in composed option", EndpointGame, []Option{ComposeOptions(SetLimit(5), SetLimit(10))}, ErrDuplicateOption},
+		{"Multiple filters in composed option", EndpointGame, []Option{ComposeOptions(SetFilter("hypes", OpGreaterThan, "5"), SetFilter("rating", OpLessThan, "50"))}, nil},
+		{"Nested composed options", EndpointGame, []Option{ComposeOptions(SetFields("name"), ComposeOptions(SetLimit(5), SetFields("slug")))}, ErrDuplicateOption},
+		{"Search limit within cap", EndpointSearch, []Option{setSearch("zelda"), SetLimit(50)}, nil},
+		{"Search limit above cap", EndpointSearch, []Option{setSearch("zelda"), SetLimit(500)}, ErrLimitExceeded},
+		{"Game limit above search cap", EndpointGame, []Option{setSearch("zelda"), SetLimit(500)}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := LintOptions(test.end, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_RequestLintsOptions(t *testing.T) {
+	ts, c := testServerString(http.StatusOK, testResult)
+	defer ts.Close()
+
+	_, err := c.Games.Search("zelda", SetOrder("hypes", OrderDescending))
+	if errors.Cause(err) != ErrSortedSearch {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrSortedSearch)
+	}
+
+	_, err = c.Games.Count(SetLimit(5))
+	if errors.Cause(err) != ErrIgnoredOption {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrIgnoredOption)
+	}
+
+	_, err = c.Search("zelda", SetLimit(100))
+	if errors.Cause(err) != ErrLimitExceeded {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrLimitExceeded)
+	}
+}
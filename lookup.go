@@ -0,0 +1,222 @@
+package igdb
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// LookupTable contains every entry of an IGDB lookup endpoint, such as the
+// Game Types or Platform Types, keyed by ID. The IGDB v4 replaces many of
+// the enums of this package with these endpoints, so a LookupTable can be
+// used to name and validate IDs that have no corresponding constant yet.
+// The object types of these endpoints, such as GameTypeLookup, all end in
+// Lookup to set them apart from the enums they replace.
+type LookupTable struct {
+	Endpoint endpoint
+	Names    map[int]string
+}
+
+// Name returns the name of the entry with the provided ID. If the table has
+// no such entry, an empty string is returned.
+func (l *LookupTable) Name(id int) string {
+	return l.Names[id]
+}
+
+// Valid returns true if the table has an entry with the provided ID.
+func (l *LookupTable) Valid(id int) bool {
+	_, ok := l.Names[id]
+	return ok
+}
+
+// IDs returns the ID of every entry in the table in ascending order.
+func (l *LookupTable) IDs() []int {
+	ids := make([]int, 0, len(l.Names))
+	for id := range l.Names {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+// lookupNames maps each lookup endpoint to the JSON tag
+// of the field that holds the name of its entries.
+var lookupNames = map[endpoint]string{
+	EndpointAgeRatingCategory:     "rating",
+	EndpointAgeRatingOrganization: "name",
+	EndpointCharacterGender:       "name",
+	EndpointCharacterSpecies:      "name",
+	EndpointDateFormat:            "format",
+	EndpointExternalGameSource:    "name",
+	EndpointGameStatus:            "status",
+	EndpointGameType:              "type",
+	EndpointPlatformType:          "name",
+	EndpointReleaseDateStatus:     "name",
+	EndpointWebsiteType:           "type",
+}
+
+// Lookup retrieves every entry of the provided lookup endpoint, such as
+// EndpointGameType or EndpointWebsiteType, and returns them as a LookupTable.
+// If the endpoint is not a lookup endpoint, an error is returned.
+func (c *Client) Lookup(end endpoint) (*LookupTable, error) {
+	tag, ok := lookupNames[end]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownEndpoint, "cannot look up entries of '%s' endpoint", end)
+	}
+
+	res := reflect.New(reflect.SliceOf(reflect.PtrTo(modelTypes[end])))
+	if err := c.postAll(end, res.Interface(), SetFields("id", tag)); err != nil {
+		return nil, errors.Wrapf(err, "cannot look up entries of '%s' endpoint", end)
+	}
+
+	l := &LookupTable{Endpoint: end, Names: make(map[int]string, res.Elem().Len())}
+	for i := 0; i < res.Elem().Len(); i++ {
+		obj := res.Elem().Index(i)
+		if obj.IsNil() {
+			continue
+		}
+		l.Names[int(obj.Elem().FieldByName("ID").Int())] = fieldByTag(obj, tag).String()
+	}
+
+	return l, nil
+}
+
+// enumLookup describes the enum type a lookup endpoint replaces
+// along with the values of its hardcoded constants.
+type enumLookup struct {
+	enum   string
+	values []int
+}
+
+// enumLookups maps each lookup endpoint that replaces an enum
+// of this package to that enum and its constants.
+var enumLookups = map[endpoint]enumLookup{
+	EndpointAgeRatingCategory: {"AgeRatingEnum", []int{
+		int(AgeRatingThree), int(AgeRatingSeven), int(AgeRatingTwelve), int(AgeRatingSixteen),
+		int(AgeRatingEighteen), int(AgeRatingRP), int(AgeRatingEC), int(AgeRatingE),
+		int(AgeRatingE10), int(AgeRatingT), int(AgeRatingM), int(AgeRatingAO),
+	}},
+	EndpointAgeRatingOrganization: {"AgeRatingCategory", []int{
+		int(AgeRatingESRB), int(AgeRatingPEGI),
+	}},
+	EndpointCharacterGender: {"CharacterGender", []int{
+		int(GenderMale), int(GenderFemale), int(GenderOther),
+	}},
+	EndpointCharacterSpecies: {"CharacterSpecies", []int{
+		int(SpeciesHuman), int(SpeciesAlien), int(SpeciesAnimal), int(SpeciesAndroid), int(SpeciesUnknown),
+	}},
+	EndpointDateFormat: {"DateCategory", []int{
+		int(DateYYYYMMMMDD), int(DateYYYYMMMM), int(DateYYYY), int(DateYYYYQ1),
+		int(DateYYYYQ2), int(DateYYYYQ3), int(DateYYYYQ4), int(DateTBD),
+	}},
+	EndpointExternalGameSource: {"ExternalGameCategory", []int{
+		int(ExternalSteam), int(ExternalGOG), int(ExternalYoutube), int(ExternalMicrosoft),
+		int(ExternalApple), int(ExternalTwitch), int(ExternalAndroid), int(ExternalAmazonASIN),
+		int(ExternalAmazonLuna), int(ExternalAmazonADG), int(ExternalEpicGameStore), int(ExternalOculus),
+		int(ExternalUtomik), int(ExternalItchIO), int(ExternalXboxMarketplace), int(ExternalKartridge),
+		int(ExternalPlaystationStoreUS), int(ExternalFocusEntertainment), int(ExternalXboxGamePassUltimateCloud),
+		int(ExternalGamejolt),
+	}},
+	EndpointGameStatus: {"GameStatus", []int{
+		int(StatusReleased), int(StatusAlpha), int(StatusBeta), int(StatusEarlyAccess),
+		int(StatusOffline), int(StatusCancelled),
+	}},
+	EndpointGameType: {"GameCategory", []int{
+		int(MainGame), int(DLCAddon), int(Expansion), int(Bundle),
+		int(StandaloneExpansion), int(Mod), int(Episode), int(Season),
+	}},
+	EndpointPlatformType: {"PlatformCategory", []int{
+		int(PlatformConsole), int(PlatformArcade), int(PlatformPlatform),
+		int(PlatformOperatingSystem), int(PlatformPortableConsole), int(PlatformComputer),
+	}},
+	EndpointWebsiteType: {"WebsiteCategory", []int{
+		int(WebsiteOfficial), int(WebsiteWikia), int(WebsiteWikipedia), int(WebsiteFacebook),
+		int(WebsiteTwitter), int(WebsiteTwitch), int(WebsiteInstagram), int(WebsiteYoutube),
+		int(WebsiteIphone), int(WebsiteIpad), int(WebsiteAndroid), int(WebsiteSteam),
+		int(WebsiteReddit), int(WebsiteDiscord), int(WebsiteGooglePlus), int(WebsiteTumblr),
+		int(WebsiteLinkedin), int(WebsitePinterest), int(WebsiteSoundcloud),
+	}},
+}
+
+// EnumReport describes the differences between the entries an IGDB lookup
+// endpoint serves and the hardcoded constants of the enum it replaces. An
+// empty report means the constants cover exactly the entries of the endpoint.
+type EnumReport struct {
+	Endpoint endpoint
+	// Enum is the name of the enum type the endpoint replaces.
+	Enum string
+	// Unknown contains the IDs served by the IGDB
+	// that have no corresponding constant.
+	Unknown []int
+	// Missing contains the values of the constants
+	// that the IGDB no longer serves.
+	Missing []int
+	// Lookup contains the entries served by the IGDB, which can be used
+	// to name the Unknown IDs.
+	Lookup *LookupTable
+}
+
+// Conforms returns true if the report contains no unknown or missing values.
+func (r *EnumReport) Conforms() bool {
+	return len(r.Unknown) == 0 && len(r.Missing) == 0
+}
+
+// ReconcileEnum compares the entries of the provided LookupTable, as returned
+// by the Lookup function, with the constants of the enum its endpoint
+// replaces. If the endpoint does not replace an enum, an error is returned.
+func ReconcileEnum(l *LookupTable) (*EnumReport, error) {
+	e, ok := enumLookups[l.Endpoint]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownEndpoint, "cannot reconcile enum of '%s' endpoint", l.Endpoint)
+	}
+
+	r := &EnumReport{Endpoint: l.Endpoint, Enum: e.enum, Lookup: l}
+
+	known := make(map[int]bool, len(e.values))
+	for _, v := range e.values {
+		known[v] = true
+		if !l.Valid(v) {
+			r.Missing = append(r.Missing, v)
+		}
+	}
+
+	for _, id := range l.IDs() {
+		if !known[id] {
+			r.Unknown = append(r.Unknown, id)
+		}
+	}
+
+	sort.Ints(r.Missing)
+
+	return r, nil
+}
+
+// ReconcileEnums retrieves the entries of every lookup endpoint that replaces
+// an enum of this package and compares them with the constants of that enum.
+// The reports are returned in order of endpoint.
+func (c *Client) ReconcileEnums() ([]*EnumReport, error) {
+	ends := make([]string, 0, len(enumLookups))
+	for end := range enumLookups {
+		ends = append(ends, string(end))
+	}
+	sort.Strings(ends)
+
+	var reports []*EnumReport
+	for _, end := range ends {
+		l, err := c.Lookup(endpoint(end))
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := ReconcileEnum(l)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, r)
+	}
+
+	return reports, nil
+}
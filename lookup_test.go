@@ -0,0 +1,288 @@
+package igdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"net/http"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestClient_Lookup(t *testing.T) {
+	ts, c := testServerRoutes(map[string]string{
+		"/website_types/": `[{"id": 1, "type": "Official Website"}, {"id": 13, "type": "Steam"}, {"id": 23, "type": "Bluesky"}]`,
+	})
+	defer ts.Close()
+
+	l, err := c.Lookup(EndpointWebsiteType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if l.Endpoint != EndpointWebsiteType {
+		t.Errorf("got: <%v>, want: <%v>", l.Endpoint, EndpointWebsiteType)
+	}
+
+	if l.Name(int(WebsiteSteam)) != "Steam" || l.Name(23) != "Bluesky" {
+		t.Errorf("got: <%v>, want: <names of Steam and Bluesky>", l.Names)
+	}
+
+	if l.Valid(int(WebsiteWikia)) {
+		t.Errorf("got: <valid>, want: <invalid> for ID %v", WebsiteWikia)
+	}
+
+	if ids := l.IDs(); !reflect.DeepEqual(ids, []int{1, 13, 23}) {
+		t.Errorf("got: <%v>, want: <%v>", ids, []int{1, 13, 23})
+	}
+}
+
+func TestLookupReferences(t *testing.T) {
+	var tests = []struct {
+		name   string
+		obj    interface{}
+		resp   string
+		field  string
+		wantID int
+	}{
+		{"AgeRating organization", &AgeRating{}, `{"id": 1, "organization": 2}`, "organization", 2},
+		{"AgeRating rating category", &AgeRating{}, `{"id": 1, "rating_category": 11}`, "rating_category", 11},
+		{"Character gender", &Character{}, `{"id": 1, "character_gender": 1}`, "character_gender", 1},
+		{"Character species", &Character{}, `{"id": 1, "character_species": 3}`, "character_species", 3},
+		{"ExternalGame source", &ExternalGame{}, `{"id": 1, "external_game_source": 54}`, "external_game_source", 54},
+		{"Platform type", &Platform{}, `{"id": 1, "platform_type": 6}`, "platform_type", 6},
+		{"ReleaseDate format", &ReleaseDate{}, `{"id": 1, "date_format": 2}`, "date_format", 2},
+		{"ReleaseDate status", &ReleaseDate{}, `{"id": 1, "status": 4}`, "status", 4},
+		{"Website type", &Website{}, `{"id": 1, "type": 23}`, "type", 23},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(test.resp), test.obj); err != nil {
+				t.Fatal(err)
+			}
+
+			end, ok := typeEndpoint(reflect.TypeOf(test.obj))
+			if !ok {
+				t.Fatalf("got: <no endpoint>, want: <endpoint> for %T", test.obj)
+			}
+
+			target, ok := references[end][test.field]
+			if !ok {
+				t.Fatalf("got: <no reference>, want: <reference> for '%s' of '%s' endpoint", test.field, end)
+			}
+
+			if _, ok := lookupNames[target]; !ok {
+				t.Errorf("got: <%v>, want: <lookup endpoint>", target)
+			}
+
+			if ids := referencedIDs(fieldByTag(reflect.ValueOf(test.obj), test.field)); len(ids) != 1 || ids[0] != test.wantID {
+				t.Errorf("got: <%v>, want: <%v>", ids, test.wantID)
+			}
+		})
+	}
+}
+
+func TestClient_LookupErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		end     endpoint
+		wantErr error
+	}{
+		{"Non-lookup endpoint", http.StatusOK, "[]", EndpointGame, ErrUnknownEndpoint},
+		{"Empty response", http.StatusOK, "", EndpointGameType, errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", EndpointGameType, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			l, err := c.Lookup(test.end)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if l != nil {
+				t.Errorf("got: <%v>, want: <nil>", l)
+			}
+		})
+	}
+}
+
+func TestReconcileEnum(t *testing.T) {
+	l := &LookupTable{
+		Endpoint: EndpointPlatformType,
+		Names: map[int]string{
+			1: "console",
+			2: "arcade",
+			3: "platform",
+			4: "operating_system",
+			6: "computer",
+			7: "cloud",
+		},
+	}
+
+	r, err := ReconcileEnum(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Enum != "PlatformCategory" || r.Lookup != l {
+		t.Errorf("got: <%v, %v>, want: <PlatformCategory, provided lookup>", r.Enum, r.Lookup)
+	}
+
+	if !reflect.DeepEqual(r.Unknown, []int{7}) {
+		t.Errorf("got: <%v>, want: <%v>", r.Unknown, []int{7})
+	}
+
+	if !reflect.DeepEqual(r.Missing, []int{int(PlatformPortableConsole)}) {
+		t.Errorf("got: <%v>, want: <%v>", r.Missing, []int{int(PlatformPortableConsole)})
+	}
+
+	if r.Conforms() {
+		t.Errorf("got: <conforms>, want: <does not conform>")
+	}
+
+	if _, err := ReconcileEnum(&LookupTable{Endpoint: EndpointReleaseDateStatus}); errors.Cause(err) != ErrUnknownEndpoint {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrUnknownEndpoint)
+	}
+}
+
+func TestEnumLookups(t *testing.T) {
+	for end := range enumLookups {
+		if _, ok := lookupNames[end]; !ok {
+			t.Errorf("got: <no name field>, want: <name field> for '%s' endpoint", end)
+		}
+	}
+
+	for end, tag := range lookupNames {
+		if !fieldByTag(reflect.New(modelTypes[end]), tag).IsValid() {
+			t.Errorf("got: <no field>, want: <field '%s'> for '%s' endpoint", tag, end)
+		}
+	}
+}
+
+// testDeclaredEnums type checks the non-test source files of the package
+// and returns the values of every constant declared with one of the provided
+// type names, keyed by type name and constant name.
+func testDeclaredEnums(names map[string]bool) (map[string]map[string]int, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, f := range pkgs["igdb"].Files {
+		files = append(files, f)
+	}
+
+	// Constant declarations do not depend on the imported packages, so
+	// errors from the stubbed importer are ignored.
+	conf := types.Config{
+		Importer: testStubImporter{},
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check("igdb", fset, files, nil)
+
+	declared := make(map[string]map[string]int)
+	for _, name := range pkg.Scope().Names() {
+		c, ok := pkg.Scope().Lookup(name).(*types.Const)
+		if !ok {
+			continue
+		}
+
+		n, ok := c.Type().(*types.Named)
+		if !ok || !names[n.Obj().Name()] {
+			continue
+		}
+
+		if declared[n.Obj().Name()] == nil {
+			declared[n.Obj().Name()] = make(map[string]int)
+		}
+		v, _ := strconv.Atoi(c.Val().ExactString())
+		declared[n.Obj().Name()][name] = v
+	}
+
+	return declared, nil
+}
+
+// testStubImporter fails to import every package.
+type testStubImporter struct{}
+
+func (testStubImporter) Import(path string) (*types.Package, error) {
+	return nil, fmt.Errorf("cannot import %s in test", path)
+}
+
+func TestEnumLookupsDeclared(t *testing.T) {
+	names := make(map[string]bool)
+	for _, e := range enumLookups {
+		names[e.enum] = true
+	}
+
+	declared, err := testDeclaredEnums(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for end, e := range enumLookups {
+		if len(declared[e.enum]) == 0 {
+			t.Errorf("got: <no constants>, want: <constants> of enum %s for '%s' endpoint", e.enum, end)
+		}
+
+		listed := make(map[int]bool, len(e.values))
+		for _, v := range e.values {
+			listed[v] = true
+		}
+
+		for name, v := range declared[e.enum] {
+			if !listed[v] {
+				t.Errorf("got: <missing>, want: <%s (%d)> in enumLookups of '%s' endpoint", name, v, end)
+			}
+		}
+	}
+}
+
+func TestClient_ReconcileEnums(t *testing.T) {
+	var tests = []struct {
+		name        string
+		status      int
+		resp        string
+		wantReports int
+		wantErr     error
+	}{
+		{"OK status with regular response", http.StatusOK, `[{"id": 1, "name": "Steam"}]`, len(enumLookups), nil},
+		{"OK status with empty response", http.StatusOK, "", 0, errInvalidJSON},
+		{"Bad status with empty response", http.StatusBadRequest, "", 0, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			r, err := c.ReconcileEnums()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if len(r) != test.wantReports {
+				t.Errorf("got: <%v> reports, want: <%v> reports", len(r), test.wantReports)
+			}
+		})
+	}
+}
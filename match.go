@@ -0,0 +1,312 @@
+package igdb
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+)
+
+// DefaultMatchInterval is the interval used between the requests of MatchAll
+// when no interval is provided. It keeps MatchAll within the IGDB's rate
+// limit of 4 requests per second.
+const DefaultMatchInterval = time.Second / 4
+
+// matchCandidates is the number of Games searched for each MatchQuery.
+const matchCandidates = 10
+
+// timeSleep pauses for the provided duration. Replaced in tests
+// alongside timeNow to space requests without waiting.
+var timeSleep = time.Sleep
+
+// MatchQuery describes a game title from an external source, such as a
+// storefront export, to be matched to a Game. The Year and Platform are
+// optional; when provided, Games released in that year or on that IGDB
+// platform are ranked higher.
+type MatchQuery struct {
+	Title    string `json:"title"`
+	Year     int    `json:"year"`
+	Platform int    `json:"platform"`
+}
+
+// Match is a candidate Game for a MatchQuery. Confidence ranges from 0 to 1,
+// where 1 means the normalized title equals the name or an alternative name
+// of the Game and the Game matches the year and platform of the query, if
+// provided. MatchedName is the name or alternative name of the Game that was
+// the closest to the title.
+type Match struct {
+	Game        *Game   `json:"game"`
+	MatchedName string  `json:"matched_name"`
+	Confidence  float64 `json:"confidence"`
+}
+
+// Match returns the Games that the provided MatchQuery most likely refers to,
+// ranked by confidence from highest to lowest. Candidates are found by
+// searching the IGDB for the title without its edition suffix and are
+// compared with the title by their names and alternative names after
+// normalizing editions, roman numerals, and punctuation, then weighed by
+// their release year and platforms. If no candidates are found, an error is
+// returned.
+func (gs *GameService) Match(q MatchQuery) ([]*Match, error) {
+	return gs.match(q, func() {})
+}
+
+// MatchAll matches every provided MatchQuery in turn and returns their
+// Matches in the same order. Requests are sent no closer together than the
+// provided interval; an interval of zero uses the DefaultMatchInterval.
+// Queries with a blank title or without any candidates are given nil Matches
+// instead of stopping the batch. Any other error stops the batch and is
+// returned.
+func (gs *GameService) MatchAll(qs []MatchQuery, interval time.Duration) ([][]*Match, error) {
+	if interval <= 0 {
+		interval = DefaultMatchInterval
+	}
+
+	var last time.Time
+	wait := func() {
+		if !last.IsZero() {
+			if d := interval - timeNow().Sub(last); d > 0 {
+				timeSleep(d)
+			}
+		}
+		last = timeNow()
+	}
+
+	res := make([][]*Match, len(qs))
+	for i, q := range qs {
+		m, err := gs.match(q, wait)
+		if cause := errors.Cause(err); cause == ErrNoResults || cause == ErrEmptyQry {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res[i] = m
+	}
+
+	return res, nil
+}
+
+// match matches the provided MatchQuery, calling wait before every request.
+func (gs *GameService) match(q MatchQuery, wait func()) ([]*Match, error) {
+	title := NormalizeTitle(q.Title)
+	if title == "" {
+		return nil, ErrEmptyQry
+	}
+
+	if q.Platform < 0 {
+		return nil, ErrNegativeID
+	}
+
+	qry := searchTitle(q.Title)
+	if qry == "" {
+		qry = title
+	}
+
+	wait()
+	games, err := gs.Search(qry,
+		SetFields("name", "first_release_date", "platforms"),
+		SetLimit(matchCandidates),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot match title '%s'", q.Title)
+	}
+
+	ids := make([]int, len(games))
+	for i, g := range games {
+		ids[i] = g.ID
+	}
+
+	wait()
+	var alts []*AlternativeName
+	err = gs.client.postAll(EndpointAlternativeName, &alts,
+		SetFields("game", "name"),
+		SetFilter("game", OpContainsAtLeast, sliceconv.Itoa(ids)...),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot match title '%s'", q.Title)
+	}
+
+	names := make(map[int][]string, len(games))
+	for _, a := range alts {
+		names[a.Game] = append(names[a.Game], a.Name)
+	}
+
+	matches := make([]*Match, 0, len(games))
+	for _, g := range games {
+		m := &Match{Game: g}
+		for _, n := range append([]string{g.Name}, names[g.ID]...) {
+			if s := titleSimilarity(title, NormalizeTitle(n)); s > m.Confidence {
+				m.Confidence, m.MatchedName = s, n
+			}
+		}
+
+		m.Confidence *= yearWeight(q.Year, g.FirstReleaseDate) * platformWeight(q.Platform, g.Platforms)
+		matches = append(matches, m)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Confidence != matches[j].Confidence {
+			return matches[i].Confidence > matches[j].Confidence
+		}
+		return matches[i].Game.ID < matches[j].Game.ID
+	})
+
+	return matches, nil
+}
+
+// editionPattern matches the edition suffixes storefronts commonly add to a
+// title, such as "GOTY" or "Collector's Edition", regardless of case.
+var editionPattern = regexp.MustCompile(`(?i)\b(game\s+of\s+the\s+year|goty)(\s+edition)?\b|\b(collector'?s|complete|definitive|digital\s+deluxe|deluxe|enhanced|gold|launch|premium|special|standard|ultimate)\s+edition\b`)
+
+// emptyBracketPattern matches the brackets left behind
+// when an edition suffix is removed from a title.
+var emptyBracketPattern = regexp.MustCompile(`\(\s*\)|\[\s*\]`)
+
+// romanPattern matches a lowercase roman numeral from I to XXXIX.
+var romanPattern = regexp.MustCompile(`^x{0,3}(ix|iv|v?i{0,3})$`)
+
+// romanValues maps each roman numeral digit to its value.
+var romanValues = map[byte]int{'i': 1, 'v': 5, 'x': 10}
+
+// searchTitle returns the provided title with its edition suffix removed but
+// otherwise as written, so that the IGDB's search is not misled by the
+// suffix while still matching the title's own spelling and numerals.
+func searchTitle(title string) string {
+	s := editionPattern.ReplaceAllString(title, " ")
+	s = emptyBracketPattern.ReplaceAllString(s, " ")
+
+	return strings.TrimRight(strings.Join(strings.Fields(s), " "), " -–—:,")
+}
+
+// NormalizeTitle returns the provided game title in a form suitable for
+// comparison with other titles. The title is lowercased, ampersands are
+// spelled out, apostrophes and other punctuation are removed, a leading
+// "the" and common edition suffixes such as "Game of the Year Edition" or
+// "GOTY" are dropped, and roman numerals are replaced by arabic numerals.
+// For example, "The Witcher III: Wild Hunt - GOTY" becomes
+// "witcher 3 wild hunt". A lone "I" or "X" is left as is, since it is as
+// likely to be a letter, as in "Mega Man X", as a numeral.
+func NormalizeTitle(title string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r == '&':
+			b.WriteString(" and ")
+		case r == '\'' || r == '’' || r == '™' || r == '®' || r == '©':
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+
+	s := editionPattern.ReplaceAllString(strings.Join(strings.Fields(b.String()), " "), " ")
+
+	words := strings.Fields(s)
+	if len(words) > 1 && words[0] == "the" {
+		words = words[1:]
+	}
+
+	for i, w := range words {
+		if w != "i" && w != "x" && romanPattern.MatchString(w) {
+			words[i] = strconv.Itoa(romanToInt(w))
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// romanToInt returns the value of the provided lowercase roman numeral.
+func romanToInt(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		v := romanValues[s[i]]
+		if i+1 < len(s) && v < romanValues[s[i+1]] {
+			n -= v
+			continue
+		}
+		n += v
+	}
+
+	return n
+}
+
+// titleSimilarity returns how similar the two provided normalized titles are,
+// from 0 to 1. The score favors candidates that contain every word of the
+// query, so that a title without its subtitle, such as "witcher 3", still
+// scores highly against "witcher 3 wild hunt".
+func titleSimilarity(query, candidate string) float64 {
+	if query == candidate {
+		return 1
+	}
+
+	qw, cw := wordSet(query), wordSet(candidate)
+	if len(qw) == 0 || len(cw) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for w := range qw {
+		if cw[w] {
+			shared++
+		}
+	}
+
+	contained := float64(shared) / float64(len(qw))
+	jaccard := float64(shared) / float64(len(qw)+len(cw)-shared)
+
+	return 0.95 * (0.6*contained + 0.4*jaccard)
+}
+
+// wordSet returns the set of words in the provided string.
+func wordSet(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(s) {
+		set[w] = true
+	}
+
+	return set
+}
+
+// yearWeight returns the factor a candidate's confidence is multiplied by
+// given the year of the query and the candidate's first release date.
+func yearWeight(year int, released Timestamp) float64 {
+	switch {
+	case year == 0:
+		return 1
+	case released.IsZero():
+		return 0.9
+	}
+
+	diff := released.Time().Year() - year
+	switch {
+	case diff == 0:
+		return 1
+	case diff == 1 || diff == -1:
+		return 0.9
+	default:
+		return 0.7
+	}
+}
+
+// platformWeight returns the factor a candidate's confidence is multiplied by
+// given the platform of the query and the candidate's platforms.
+func platformWeight(platform int, platforms []int) float64 {
+	if platform == 0 {
+		return 1
+	}
+
+	for _, p := range platforms {
+		if p == platform {
+			return 1
+		}
+	}
+
+	return 0.75
+}
@@ -0,0 +1,242 @@
+package igdb
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	testMatchGames = `[
+		{"id": 478, "name": "The Witcher 2: Assassins of Kings", "first_release_date": 1305590400, "platforms": [6, 12]},
+		{"id": 1942, "name": "The Witcher 3: Wild Hunt", "first_release_date": 1431993600, "platforms": [6, 48, 49]},
+		{"id": 80, "name": "The Witcher", "first_release_date": 1193097600, "platforms": [6]}
+	]`
+	testMatchAlternativeNames = `[
+		{"id": 1, "game": 1942, "name": "Witcher III"},
+		{"id": 2, "game": 478, "name": "Wiedźmin 2: Zabójcy królów"}
+	]`
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	var tests = []struct {
+		title     string
+		wantTitle string
+	}{
+		{"The Witcher 3: Wild Hunt", "witcher 3 wild hunt"},
+		{"Witcher 3 GOTY", "witcher 3"},
+		{"The Witcher III: Wild Hunt - Game of the Year Edition", "witcher 3 wild hunt"},
+		{"Final Fantasy VII", "final fantasy 7"},
+		{"Final Fantasy XIV: A Realm Reborn", "final fantasy 14 a realm reborn"},
+		{"Ratchet & Clank", "ratchet and clank"},
+		{"Assassin's Creed® II Deluxe Edition", "assassins creed 2"},
+		{"Pokémon Gold", "pokémon gold"},
+		{"Ultimate Marvel vs. Capcom 3", "ultimate marvel vs capcom 3"},
+		{"Mega Man X", "mega man x"},
+		{"Mega Man 10", "mega man 10"},
+		{"Mega Man X3", "mega man x3"},
+		{"Spyro: Collector's Edition", "spyro"},
+		{"The", "the"},
+		{"  ", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			if title := NormalizeTitle(test.title); title != test.wantTitle {
+				t.Errorf("got: <%v>, want: <%v>", title, test.wantTitle)
+			}
+		})
+	}
+}
+
+func TestSearchTitle(t *testing.T) {
+	var tests = []struct {
+		title     string
+		wantTitle string
+	}{
+		{"Final Fantasy VII", "Final Fantasy VII"},
+		{"The Witcher III: Wild Hunt - Game of the Year Edition", "The Witcher III: Wild Hunt"},
+		{"Witcher 3 GOTY", "Witcher 3"},
+		{"Fallout 4 (GOTY)", "Fallout 4"},
+		{"Assassin's Creed® II Deluxe Edition", "Assassin's Creed® II"},
+		{"Spyro: Collector's Edition", "Spyro"},
+		{"Pokémon Gold", "Pokémon Gold"},
+		{"GOTY", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			if title := searchTitle(test.title); title != test.wantTitle {
+				t.Errorf("got: <%v>, want: <%v>", title, test.wantTitle)
+			}
+		})
+	}
+}
+
+func TestTitleSimilarity(t *testing.T) {
+	exact := titleSimilarity("witcher 3 wild hunt", "witcher 3 wild hunt")
+	prefix := titleSimilarity("witcher 3", "witcher 3 wild hunt")
+	sequel := titleSimilarity("witcher 3", "witcher 2 assassins of kings")
+
+	if exact != 1 {
+		t.Errorf("got: <%v>, want: <%v>", exact, 1)
+	}
+
+	if !(exact > prefix && prefix > sequel && sequel > 0) {
+		t.Errorf("got: <%v, %v, %v>, want: <descending scores>", exact, prefix, sequel)
+	}
+
+	if s := titleSimilarity("witcher", ""); s != 0 {
+		t.Errorf("got: <%v>, want: <%v>", s, 0)
+	}
+}
+
+func TestGameService_Match(t *testing.T) {
+	ts, c := testServerRoutes(map[string]string{
+		"/games/":             testMatchGames,
+		"/alternative_names/": testMatchAlternativeNames,
+	})
+	defer ts.Close()
+
+	m, err := c.Games.Match(MatchQuery{Title: "Witcher III GOTY", Year: 2015, Platform: 48})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m) != 3 {
+		t.Fatalf("got: <%v> matches, want: <%v> matches", len(m), 3)
+	}
+
+	if m[0].Game.ID != 1942 || m[0].MatchedName != "Witcher III" || m[0].Confidence != 1 {
+		t.Errorf("got: <%v, %v, %v>, want: <%v, %v, %v>", m[0].Game.ID, m[0].MatchedName, m[0].Confidence, 1942, "Witcher III", 1)
+	}
+
+	if m[1].Confidence >= m[0].Confidence || m[2].Confidence > m[1].Confidence {
+		t.Errorf("got: <%v, %v, %v>, want: <descending confidence>", m[0].Confidence, m[1].Confidence, m[2].Confidence)
+	}
+
+	plain, err := c.Games.Match(MatchQuery{Title: "Witcher 3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plain[0].Game.ID != 1942 {
+		t.Errorf("got: <%v>, want: <%v>", plain[0].Game.ID, 1942)
+	}
+
+	old, err := c.Games.Match(MatchQuery{Title: "Witcher 3", Year: 2007, Platform: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if old[0].Confidence >= plain[0].Confidence {
+		t.Errorf("got: <%v>, want: <less than %v>", old[0].Confidence, plain[0].Confidence)
+	}
+}
+
+func TestGameService_MatchErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		q       MatchQuery
+		wantErr error
+	}{
+		{"Empty title", http.StatusOK, "[]", MatchQuery{Title: " - "}, ErrEmptyQry},
+		{"Negative platform", http.StatusOK, "[]", MatchQuery{Title: "Portal", Platform: -6}, ErrNegativeID},
+		{"No results", http.StatusOK, "[]", MatchQuery{Title: "Portal"}, ErrNoResults},
+		{"Empty response", http.StatusOK, "", MatchQuery{Title: "Portal"}, errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", MatchQuery{Title: "Portal"}, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			m, err := c.Games.Match(test.q)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if m != nil {
+				t.Errorf("got: <%v>, want: <nil>", m)
+			}
+		})
+	}
+}
+
+func TestGameService_MatchAll(t *testing.T) {
+	var mu sync.Mutex
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	timeSleep = func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+	defer func() { timeNow, timeSleep = time.Now, time.Sleep }()
+
+	var times []time.Time
+	var searches []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, timeNow())
+		b, _ := ioutil.ReadAll(r.Body)
+		if r.URL.Path == "/games/" {
+			searches = append(searches, string(b))
+		}
+		switch {
+		case strings.Contains(string(b), "zzz"):
+			io.WriteString(w, "[]")
+		case r.URL.Path == "/games/":
+			io.WriteString(w, testMatchGames)
+		default:
+			io.WriteString(w, testMatchAlternativeNames)
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	interval := 20 * time.Millisecond
+	qs := []MatchQuery{{Title: "Witcher III - GOTY"}, {Title: "zzz"}, {Title: " "}, {Title: "The Witcher"}}
+
+	res, err := c.Games.MatchAll(qs, interval)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res) != 4 || res[1] != nil || res[2] != nil {
+		t.Fatalf("got: <%v>, want: <4 results with nil second and third results>", res)
+	}
+
+	if res[0][0].Game.ID != 1942 || res[3][0].Game.ID != 80 {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", res[0][0].Game.ID, res[3][0].Game.ID, 1942, 80)
+	}
+
+	if len(searches) == 0 || !strings.Contains(searches[0], `"Witcher III"`) {
+		t.Errorf("got: <%v>, want: <search for %q>", searches, "Witcher III")
+	}
+
+	if len(times) != 5 {
+		t.Fatalf("got: <%v> requests, want: <%v> requests", len(times), 5)
+	}
+
+	for i := 1; i < len(times); i++ {
+		if d := times[i].Sub(times[i-1]); d < interval {
+			t.Errorf("got: <%v> between requests, want: <at least %v>", d, interval)
+		}
+	}
+}
@@ -11,6 +11,9 @@ import (
 // MultiplayerMode contains data about the supported multiplayer types.
 // For more information visit: https://api-docs.igdb.com/#multiplayer-mode
 type MultiplayerMode struct {
+	Presence
+	Extras
+	ID                int  `json:"id"`
 	Campaigncoop      bool `json:"campaigncoop"`
 	Dropin            bool `json:"dropin"`
 	Lancoop           bool `json:"lancoop"`
@@ -25,6 +28,13 @@ type MultiplayerMode struct {
 	Splitscreenonline bool `json:"splitscreenonline"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the MultiplayerMode and
+// records which of its fields were present or unrecognized.
+func (m *MultiplayerMode) UnmarshalJSON(b []byte) error {
+	type multiplayerMode MultiplayerMode
+	return decodeModel(b, (*multiplayerMode)(m), &m.Presence, &m.Extras)
+}
+
 // MultiplayerModeService handles all the API calls for the IGDB MultiplayerMode endpoint.
 type MultiplayerModeService service
 
@@ -0,0 +1,130 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct NetworkType -add-tags json -w
+
+// NetworkType represents a social media or streaming network such as
+// Twitch or YouTube.
+// For more information visit: https://api-docs.igdb.com/#network-type
+type NetworkType struct {
+	Presence
+	Extras
+	ID            int       `json:"id"`
+	Checksum      string    `json:"checksum"`
+	CreatedAt     Timestamp `json:"created_at"`
+	EventNetworks []int     `json:"event_networks"`
+	Name          string    `json:"name"`
+	UpdatedAt     Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the NetworkType and
+// records which of its fields were present or unrecognized.
+func (n *NetworkType) UnmarshalJSON(b []byte) error {
+	type networkType NetworkType
+	return decodeModel(b, (*networkType)(n), &n.Presence, &n.Extras)
+}
+
+// NetworkTypeService handles all the API calls for the IGDB NetworkType endpoint.
+type NetworkTypeService service
+
+// Get returns a single NetworkType identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any NetworkTypes, an error is returned.
+func (ns *NetworkTypeService) Get(id int, opts ...Option) (*NetworkType, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var nt []*NetworkType
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ns.client.post(ns.end, &nt, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get NetworkType with ID %v", id)
+	}
+
+	return nt[0], nil
+}
+
+// List returns a list of NetworkTypes identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a NetworkType is ignored. If none of the IDs
+// match a NetworkType, an error is returned.
+func (ns *NetworkTypeService) List(ids []int, opts ...Option) ([]*NetworkType, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var nt []*NetworkType
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ns.client.post(ns.end, &nt, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get NetworkTypes with IDs %v", ids)
+	}
+
+	return nt, nil
+}
+
+// Index returns an index of NetworkTypes based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no NetworkTypes can
+// be found using the provided options, an error is returned.
+func (ns *NetworkTypeService) Index(opts ...Option) ([]*NetworkType, error) {
+	var nt []*NetworkType
+
+	err := ns.client.post(ns.end, &nt, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of NetworkTypes")
+	}
+
+	return nt, nil
+}
+
+// Search returns a list of NetworkTypes found by searching the IGDB using the provided
+// query. Provide functional options to sort, filter, and paginate the results. If
+// no NetworkTypes are found using the provided query, an error is returned.
+func (ns *NetworkTypeService) Search(qry string, opts ...Option) ([]*NetworkType, error) {
+	var nt []*NetworkType
+
+	opts = append(opts, setSearch(qry))
+	err := ns.client.post(ns.end, &nt, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get NetworkType with query %s", qry)
+	}
+
+	return nt, nil
+}
+
+// Count returns the number of NetworkTypes available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which NetworkTypes to count.
+func (ns *NetworkTypeService) Count(opts ...Option) (int, error) {
+	ct, err := ns.client.getCount(ns.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count NetworkTypes")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB NetworkType object.
+func (ns *NetworkTypeService) Fields() ([]string, error) {
+	f, err := ns.client.getFields(ns.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get NetworkType fields")
+	}
+
+	return f, nil
+}
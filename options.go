@@ -2,6 +2,7 @@ package igdb
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/Henry-Sarabia/apicalypse"
@@ -13,6 +14,8 @@ import (
 var (
 	// ErrEmptyQry occurs when an empty string is used as a query value.
 	ErrEmptyQry = errors.New("provided option query value is empty")
+	// ErrEmptySlug occurs when an empty string is used as a slug value.
+	ErrEmptySlug = errors.New("provided option slug value is empty")
 	// ErrEmptyFields occurs when an empty string is used as a field value.
 	ErrEmptyFields = errors.New("one or more provided option field values are empty")
 	// ErrExpandedField occurs when a field value tries to access an expanded subfield.
@@ -32,7 +35,8 @@ type Option func() (apicalypse.Option, error)
 
 // ComposeOptions composes multiple functional options into a single Option.
 // This is primarily used to create a single functional option that can be used
-// repeatedly across multiple queries.
+// repeatedly across multiple queries. Applying the composed Option returns an
+// error if the options set anything other than SetFilter more than once.
 func ComposeOptions(opts ...Option) Option {
 	return func() (apicalypse.Option, error) {
 		unwrapped, err := unwrapOptions(opts...)
@@ -40,7 +44,40 @@ func ComposeOptions(opts ...Option) Option {
 			return nil, errors.Wrap(err, "cannot compose invalid functional options")
 		}
 
-		return apicalypse.ComposeOptions(unwrapped...), nil
+		return composeOptions(unwrapped...), nil
+	}
+}
+
+// composeOptions works like apicalypse.ComposeOptions, but applies each of the
+// provided options to its own empty query before merging it into the final
+// query so that any option overwriting a parameter set by an earlier option
+// is detected. Filters are combined rather than overwritten.
+func composeOptions(opts ...apicalypse.Option) apicalypse.Option {
+	return func(params map[string]string) error {
+		composed := make(map[string]bool)
+		for _, opt := range opts {
+			single := make(map[string]string)
+			if err := opt(single); err != nil {
+				return errors.Wrap(err, "cannot compose functional options")
+			}
+
+			for _, p := range sortedKeys(single) {
+				if p == paramWhere {
+					if err := apicalypse.Where(single[p])(params); err != nil {
+						return errors.Wrap(err, "cannot compose functional options")
+					}
+					continue
+				}
+
+				if composed[p] {
+					return errors.Wrapf(ErrDuplicateOption, "'%s' is set more than once in composed options", p)
+				}
+				composed[p] = true
+				params[p] = single[p]
+			}
+		}
+
+		return nil
 	}
 }
 
@@ -88,13 +125,16 @@ func SetOrder(field string, order order) Option {
 	}
 }
 
+// maxLimit is the maximum number of results a single API call can return.
+const maxLimit = 500
+
 // SetLimit is a functional option used to limit the number of results from
 // an API call. The default limit is 10. The maximum limit is 500.
 //
 // For more information, visit: https://api-docs.igdb.com/#pagination
 func SetLimit(lim int) Option {
 	return func() (apicalypse.Option, error) {
-		if lim <= 0 || lim > 500 {
+		if lim <= 0 || lim > maxLimit {
 			return nil, ErrOutOfRange
 		}
 
@@ -242,3 +282,37 @@ func setSearch(qry string) Option {
 		return apicalypse.Search("", qry), nil
 	}
 }
+
+// setSlug is a functional option used to filter the results from an API
+// call to the object with the provided slug.
+func setSlug(slug string) Option {
+	return func() (apicalypse.Option, error) {
+		if blank.Is(slug) {
+			return nil, ErrEmptySlug
+		}
+
+		return apicalypse.Where(fmt.Sprintf(string(OpEquals), "slug", strconv.Quote(slug))), nil
+	}
+}
+
+// setFilterAny is a functional option used to filter the results from an API
+// call to those where at least one of the provided fields satisfies the
+// provided operator with the provided values.
+func setFilterAny(op operator, val []string, fields ...string) Option {
+	return func() (apicalypse.Option, error) {
+		if len(fields) <= 0 || blank.Has(fields) {
+			return nil, ErrEmptyFields
+		}
+		if len(val) <= 0 || blank.Has(val) {
+			return nil, ErrEmptyFilterVals
+		}
+
+		j := strings.Join(val, ",")
+		conds := make([]string, len(fields))
+		for i, f := range fields {
+			conds[i] = fmt.Sprintf(string(op), f, j)
+		}
+
+		return apicalypse.Where("(" + strings.Join(conds, " | ") + ")"), nil
+	}
+}
@@ -22,6 +22,7 @@ func TestComposeOptions(t *testing.T) {
 		{"Multiple options", []Option{SetLimit(20), SetFields("name", "id"), SetFilter("hypes", OpLessThan, "50")}, []string{"50", "name,id", "where hypes < 50"}, nil},
 		{"Single invalid option", []Option{SetOffset(-500)}, nil, ErrOutOfRange},
 		{"Multiple invalid options", []Option{SetOffset(-500), SetLimit(-999)}, nil, ErrOutOfRange},
+		{"Multiple filters", []Option{SetFilter("hypes", OpLessThan, "50"), SetFilter("rating", OpGreaterThan, "80")}, []string{"hypes < 50", "rating > 80", " & "}, nil},
 	}
 
 	for _, test := range optTests {
@@ -535,3 +536,77 @@ func ExampleSetFilter() {
 		SetFilter("genres", OpContainsAtLeast, "31"),
 	)
 }
+
+func TestSetFilterAny(t *testing.T) {
+	var tests = []struct {
+		name       string
+		fields     []string
+		op         operator
+		vals       []string
+		wantFilter string
+		wantErr    error
+	}{
+		{"Single field", []string{"franchise"}, OpEquals, []string{"12"}, "(franchise = 12)", nil},
+		{"Multiple fields", []string{"parent_game", "version_parent"}, OpContainsAtLeast, []string{"1", "2"}, "(parent_game = (1,2) | version_parent = (1,2))", nil},
+		{"Empty value", []string{"franchise"}, OpEquals, []string{""}, "", ErrEmptyFilterVals},
+		{"No values", []string{"franchise"}, OpEquals, nil, "", ErrEmptyFilterVals},
+		{"Empty field", []string{"franchise", " "}, OpEquals, []string{"12"}, "", ErrEmptyFields},
+		{"No fields", nil, OpEquals, []string{"12"}, "", ErrEmptyFields},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := setFilterAny(test.op, test.vals, test.fields...)()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantFilter) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantFilter)
+			}
+		})
+	}
+}
+
+func TestSetSlug(t *testing.T) {
+	var tests = []struct {
+		name       string
+		slug       string
+		wantFilter string
+		wantErr    error
+	}{
+		{"Regular slug", "the-witcher-3-wild-hunt", `slug = "the-witcher-3-wild-hunt"`, nil},
+		{"Slug with quote", `a"b`, `slug = "a\"b"`, nil},
+		{"Empty slug", "", "", ErrEmptySlug},
+		{"Blank slug", "  ", "", ErrEmptySlug},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := setSlug(test.slug)()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantFilter) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantFilter)
+			}
+		})
+	}
+}
@@ -12,26 +12,39 @@ import (
 // or game delivery network.
 // For more information visit: https://api-docs.igdb.com/#platform
 type Platform struct {
+	Presence
+	Extras
 	ID              int              `json:"id"`
 	Abbreviation    string           `json:"abbreviation"`
 	AlternativeName string           `json:"alternative_name"`
 	Category        PlatformCategory `json:"category"`
-	CreatedAt       int              `json:"created_at"`
+	CreatedAt       Timestamp        `json:"created_at"`
 	Generation      int              `json:"generation"`
 	Name            string           `json:"name"`
 	PlatformLogo    int              `json:"platform_logo"`
+	PlatformType    int              `json:"platform_type"`
 	ProductFamily   int              `json:"product_family"`
 	Slug            string           `json:"slug"`
 	Summary         string           `json:"summary"`
-	UpdatedAt       int              `json:"updated_at"`
+	UpdatedAt       Timestamp        `json:"updated_at"`
 	URL             string           `json:"url"`
 	Versions        []int            `json:"versions"`
 	Websites        []int            `json:"websites"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the Platform and
+// records which of its fields were present or unrecognized.
+func (p *Platform) UnmarshalJSON(b []byte) error {
+	type platform Platform
+	return decodeModel(b, (*platform)(p), &p.Presence, &p.Extras)
+}
+
 //go:generate stringer -type=PlatformCategory
 
 // PlatformCategory specifies a type of platform.
+//
+// The IGDB v4 deprecates the category field of a Platform in favor
+// of the platform_type field, which holds a Platform Type ID instead.
 type PlatformCategory int
 
 // Expected PlatformCategory enums from the IGDB.
@@ -66,6 +79,25 @@ func (ps *PlatformService) Get(id int, opts ...Option) (*Platform, error) {
 	return plat[0], nil
 }
 
+// GetBySlug returns a single Platform identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Platforms, an error is returned.
+func (ps *PlatformService) GetBySlug(slug string, opts ...Option) (*Platform, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var plat []*Platform
+
+	opts = append(opts, setSlug(slug))
+	err := ps.client.post(ps.end, &plat, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Platform with slug %s", slug)
+	}
+
+	return plat[0], nil
+}
+
 // List returns a list of Platforms identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Platform is ignored. If none of the IDs
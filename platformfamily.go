@@ -11,11 +11,20 @@ import (
 // PlatformFamily represents a collection of closely related platforms.
 // For more information visit: https://api-docs.igdb.com/#platform-family
 type PlatformFamily struct {
+	Presence
+	Extras
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 	Slug string `json:"slug"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the PlatformFamily and
+// records which of its fields were present or unrecognized.
+func (p *PlatformFamily) UnmarshalJSON(b []byte) error {
+	type platformFamily PlatformFamily
+	return decodeModel(b, (*platformFamily)(p), &p.Presence, &p.Extras)
+}
+
 // PlatformFamilyService handles all the API
 // calls for the IGDB PlatformFamily endpoint.
 type PlatformFamilyService service
@@ -39,6 +48,25 @@ func (ps *PlatformFamilyService) Get(id int, opts ...Option) (*PlatformFamily, e
 	return fam[0], nil
 }
 
+// GetBySlug returns a single PlatformFamily identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any PlatformFamilies, an error is returned.
+func (ps *PlatformFamilyService) GetBySlug(slug string, opts ...Option) (*PlatformFamily, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var fam []*PlatformFamily
+
+	opts = append(opts, setSlug(slug))
+	err := ps.client.post(ps.end, &fam, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PlatformFamily with slug %s", slug)
+	}
+
+	return fam[0], nil
+}
+
 // List returns a list of PlatformFamilies identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a PlatformFamily is ignored. If none of the IDs
@@ -60,6 +60,52 @@ func TestPlatformFamilyService_Get(t *testing.T) {
 	}
 }
 
+func TestPlatformFamilyService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testPlatformFamilyGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PlatformFamily, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name               string
+		file               string
+		slug               string
+		opts               []Option
+		wantPlatformFamily *PlatformFamily
+		wantErr            error
+	}{
+		{"Valid response", testPlatformFamilyGet, "playstation", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "playstation", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "playstation", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			fam, err := c.PlatformFamilies.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(fam, test.wantPlatformFamily) {
+				t.Errorf("got: <%v>, \nwant: <%v>", fam, test.wantPlatformFamily)
+			}
+		})
+	}
+}
+
 func TestPlatformFamilyService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testPlatformFamilyList)
 	if err != nil {
@@ -10,9 +10,18 @@ import (
 // For more information visit: https://api-docs.igdb.com/#platform-logo
 type PlatformLogo struct {
 	Image
+	Presence
+	Extras
 	ID int `json:"id"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the PlatformLogo and
+// records which of its fields were present or unrecognized.
+func (p *PlatformLogo) UnmarshalJSON(b []byte) error {
+	type platformLogo PlatformLogo
+	return decodeModel(b, (*platformLogo)(p), &p.Presence, &p.Extras)
+}
+
 // PlatformLogoService handles all the API calls for the IGDB PlatformLogo endpoint.
 type PlatformLogoService service
 
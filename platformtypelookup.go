@@ -0,0 +1,114 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct PlatformTypeLookup -add-tags json -w
+
+// PlatformTypeLookup represents a type of platform such as a console or an
+// operating system. It replaces the PlatformCategory enums in the IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#platform-type
+type PlatformTypeLookup struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the PlatformTypeLookup and
+// records which of its fields were present or unrecognized.
+func (p *PlatformTypeLookup) UnmarshalJSON(b []byte) error {
+	type platformTypeLookup PlatformTypeLookup
+	return decodeModel(b, (*platformTypeLookup)(p), &p.Presence, &p.Extras)
+}
+
+// PlatformTypeLookupService handles all the API calls for the IGDB PlatformTypeLookup endpoint.
+type PlatformTypeLookupService service
+
+// Get returns a single PlatformTypeLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any PlatformTypeLookups, an error is returned.
+func (ps *PlatformTypeLookupService) Get(id int, opts ...Option) (*PlatformTypeLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var typ []*PlatformTypeLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.post(ps.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PlatformTypeLookup with ID %v", id)
+	}
+
+	return typ[0], nil
+}
+
+// List returns a list of PlatformTypeLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a PlatformTypeLookup is ignored. If none of the IDs
+// match a PlatformTypeLookup, an error is returned.
+func (ps *PlatformTypeLookupService) List(ids []int, opts ...Option) ([]*PlatformTypeLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var typ []*PlatformTypeLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ps.client.post(ps.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PlatformTypeLookups with IDs %v", ids)
+	}
+
+	return typ, nil
+}
+
+// Index returns an index of PlatformTypeLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no PlatformTypeLookups can
+// be found using the provided options, an error is returned.
+func (ps *PlatformTypeLookupService) Index(opts ...Option) ([]*PlatformTypeLookup, error) {
+	var typ []*PlatformTypeLookup
+
+	err := ps.client.post(ps.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of PlatformTypeLookups")
+	}
+
+	return typ, nil
+}
+
+// Count returns the number of PlatformTypeLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which PlatformTypeLookups to count.
+func (ps *PlatformTypeLookupService) Count(opts ...Option) (int, error) {
+	ct, err := ps.client.getCount(ps.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count PlatformTypeLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB PlatformTypeLookup object.
+func (ps *PlatformTypeLookupService) Fields() ([]string, error) {
+	f, err := ps.client.getFields(ps.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get PlatformTypeLookup fields")
+	}
+
+	return f, nil
+}
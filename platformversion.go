@@ -11,6 +11,8 @@ import (
 // PlatformVersion represents a particular version of a platform.
 // For more information visit: https://api-docs.igdb.com/#platform-version
 type PlatformVersion struct {
+	Presence
+	Extras
 	ID                          int    `json:"id"`
 	Companies                   []int  `json:"companies"`
 	Connectivity                string `json:"connectivity"`
@@ -32,6 +34,13 @@ type PlatformVersion struct {
 	URL                         string `json:"url"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the PlatformVersion and
+// records which of its fields were present or unrecognized.
+func (p *PlatformVersion) UnmarshalJSON(b []byte) error {
+	type platformVersion PlatformVersion
+	return decodeModel(b, (*platformVersion)(p), &p.Presence, &p.Extras)
+}
+
 // PlatformVersionService handles all the API calls for the IGDB PlatformVersion endpoint.
 type PlatformVersionService service
 
@@ -54,6 +63,25 @@ func (ps *PlatformVersionService) Get(id int, opts ...Option) (*PlatformVersion,
 	return ver[0], nil
 }
 
+// GetBySlug returns a single PlatformVersion identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any PlatformVersions, an error is returned.
+func (ps *PlatformVersionService) GetBySlug(slug string, opts ...Option) (*PlatformVersion, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var ver []*PlatformVersion
+
+	opts = append(opts, setSlug(slug))
+	err := ps.client.post(ps.end, &ver, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PlatformVersion with slug %s", slug)
+	}
+
+	return ver[0], nil
+}
+
 // List returns a list of PlatformVersions identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a PlatformVersion is ignored. If none of the IDs
@@ -60,6 +60,52 @@ func TestPlatformVersionService_Get(t *testing.T) {
 	}
 }
 
+func TestPlatformVersionService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testPlatformVersionGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PlatformVersion, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                string
+		file                string
+		slug                string
+		opts                []Option
+		wantPlatformVersion *PlatformVersion
+		wantErr             error
+	}{
+		{"Valid response", testPlatformVersionGet, "initial-version", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "initial-version", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "initial-version", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			ver, err := c.PlatformVersions.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(ver, test.wantPlatformVersion) {
+				t.Errorf("got: <%v>, \nwant: <%v>", ver, test.wantPlatformVersion)
+			}
+		})
+	}
+}
+
 func TestPlatformVersionService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testPlatformVersionList)
 	if err != nil {
@@ -11,6 +11,8 @@ import (
 // PlatformVersionCompany represents a platform developer.
 // For more information visit: https://api-docs.igdb.com/#platform-version-company
 type PlatformVersionCompany struct {
+	Presence
+	Extras
 	ID           int    `json:"id"`
 	Comment      string `json:"comment"`
 	Company      int    `json:"company"`
@@ -18,6 +20,13 @@ type PlatformVersionCompany struct {
 	Manufacturer bool   `json:"manufacturer"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the PlatformVersionCompany and
+// records which of its fields were present or unrecognized.
+func (p *PlatformVersionCompany) UnmarshalJSON(b []byte) error {
+	type platformVersionCompany PlatformVersionCompany
+	return decodeModel(b, (*platformVersionCompany)(p), &p.Presence, &p.Extras)
+}
+
 // PlatformVersionCompanyService handles all the API calls for the IGDB PlatformVersionCompany endpoint.
 type PlatformVersionCompanyService service
 
@@ -12,18 +12,27 @@ import (
 // Used to dig deeper into release dates, platforms, and versions.
 // For more information visit: https://api-docs.igdb.com/#platform-version-release-date
 type PlatformVersionReleaseDate struct {
+	Presence
+	Extras
 	ID              int            `json:"id"`
 	Category        DateCategory   `json:"category"`
-	CreatedAt       int            `json:"created_at"`
-	Date            int            `json:"date"`
+	CreatedAt       Timestamp      `json:"created_at"`
+	Date            Timestamp      `json:"date"`
 	Human           string         `json:"human"`
 	M               int            `json:"m"`
 	PlatformVersion int            `json:"platform_version"`
 	Region          RegionCategory `json:"region"`
-	UpdatedAt       int            `json:"updated_at"`
+	UpdatedAt       Timestamp      `json:"updated_at"`
 	Y               int            `json:"y"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the PlatformVersionReleaseDate and
+// records which of its fields were present or unrecognized.
+func (p *PlatformVersionReleaseDate) UnmarshalJSON(b []byte) error {
+	type platformVersionReleaseDate PlatformVersionReleaseDate
+	return decodeModel(b, (*platformVersionReleaseDate)(p), &p.Presence, &p.Extras)
+}
+
 // PlatformVersionReleaseDateService handles all the API calls for the IGDB PlatformVersionReleaseDate endpoint.
 type PlatformVersionReleaseDateService service
 
@@ -9,12 +9,21 @@ import (
 // PlatformWebsite represents the main website for a particular platform.
 // For more information visit: https://api-docs.igdb.com/#platform-website
 type PlatformWebsite struct {
+	Presence
+	Extras
 	ID       int             `json:"id"`
 	Category WebsiteCategory `json:"category"`
 	Trusted  bool            `json:"trusted"`
 	URL      string          `json:"url"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the PlatformWebsite and
+// records which of its fields were present or unrecognized.
+func (p *PlatformWebsite) UnmarshalJSON(b []byte) error {
+	type platformWebsite PlatformWebsite
+	return decodeModel(b, (*platformWebsite)(p), &p.Presence, &p.Extras)
+}
+
 // PlatformWebsiteService handles all the API calls for the IGDB PlatformWebsite endpoint.
 type PlatformWebsiteService service
 
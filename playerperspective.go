@@ -11,12 +11,21 @@ import (
 // PlayerPerspective describes the view or perspective of the player in a video game.
 // For more information visit: https://api-docs.igdb.com/#player-perspective
 type PlayerPerspective struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the PlayerPerspective and
+// records which of its fields were present or unrecognized.
+func (p *PlayerPerspective) UnmarshalJSON(b []byte) error {
+	type playerPerspective PlayerPerspective
+	return decodeModel(b, (*playerPerspective)(p), &p.Presence, &p.Extras)
 }
 
 // PlayerPerspectiveService handles all the API calls for the IGDB PlayerPerspective endpoint.
@@ -41,6 +50,25 @@ func (ps *PlayerPerspectiveService) Get(id int, opts ...Option) (*PlayerPerspect
 	return pp[0], nil
 }
 
+// GetBySlug returns a single PlayerPerspective identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any PlayerPerspectives, an error is returned.
+func (ps *PlayerPerspectiveService) GetBySlug(slug string, opts ...Option) (*PlayerPerspective, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var pp []*PlayerPerspective
+
+	opts = append(opts, setSlug(slug))
+	err := ps.client.post(ps.end, &pp, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PlayerPerspective with slug %s", slug)
+	}
+
+	return pp[0], nil
+}
+
 // List returns a list of PlayerPerspectives identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a PlayerPerspective is ignored. If none of the IDs
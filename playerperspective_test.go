@@ -60,6 +60,52 @@ func TestPlayerPerspectiveService_Get(t *testing.T) {
 	}
 }
 
+func TestPlayerPerspectiveService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testPlayerPerspectiveGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PlayerPerspective, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                  string
+		file                  string
+		slug                  string
+		opts                  []Option
+		wantPlayerPerspective *PlayerPerspective
+		wantErr               error
+	}{
+		{"Valid response", testPlayerPerspectiveGet, "side-view", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "side-view", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "side-view", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			pp, err := c.PlayerPerspectives.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pp, test.wantPlayerPerspective) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pp, test.wantPlayerPerspective)
+			}
+		})
+	}
+}
+
 func TestPlayerPerspectiveService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testPlayerPerspectiveList)
 	if err != nil {
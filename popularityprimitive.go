@@ -0,0 +1,174 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct PopularityPrimitive -add-tags json -w
+
+// PopularityPrimitive represents the popularity of a particular game
+// according to a particular PopularityType, such as its number of visits.
+// For more information visit: https://api-docs.igdb.com/#popularity-primitive
+type PopularityPrimitive struct {
+	Presence
+	Extras
+	ID                       int       `json:"id"`
+	CalculatedAt             Timestamp `json:"calculated_at"`
+	Checksum                 string    `json:"checksum"`
+	CreatedAt                Timestamp `json:"created_at"`
+	ExternalPopularitySource int       `json:"external_popularity_source"`
+	GameID                   int       `json:"game_id"`
+	PopularitySource         int       `json:"popularity_source"`
+	PopularityType           int       `json:"popularity_type"`
+	UpdatedAt                Timestamp `json:"updated_at"`
+	Value                    float64   `json:"value"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the PopularityPrimitive and
+// records which of its fields were present or unrecognized.
+func (p *PopularityPrimitive) UnmarshalJSON(b []byte) error {
+	type popularityPrimitive PopularityPrimitive
+	return decodeModel(b, (*popularityPrimitive)(p), &p.Presence, &p.Extras)
+}
+
+// PopularityPrimitiveService handles all the API calls for the IGDB PopularityPrimitive endpoint.
+type PopularityPrimitiveService service
+
+// Get returns a single PopularityPrimitive identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any PopularityPrimitives, an error is returned.
+func (ps *PopularityPrimitiveService) Get(id int, opts ...Option) (*PopularityPrimitive, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var pop []*PopularityPrimitive
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.post(ps.end, &pop, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PopularityPrimitive with ID %v", id)
+	}
+
+	return pop[0], nil
+}
+
+// List returns a list of PopularityPrimitives identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a PopularityPrimitive is ignored. If none of the IDs
+// match a PopularityPrimitive, an error is returned.
+func (ps *PopularityPrimitiveService) List(ids []int, opts ...Option) ([]*PopularityPrimitive, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var pop []*PopularityPrimitive
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ps.client.post(ps.end, &pop, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PopularityPrimitives with IDs %v", ids)
+	}
+
+	return pop, nil
+}
+
+// Index returns an index of PopularityPrimitives based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no PopularityPrimitives can
+// be found using the provided options, an error is returned.
+func (ps *PopularityPrimitiveService) Index(opts ...Option) ([]*PopularityPrimitive, error) {
+	var pop []*PopularityPrimitive
+
+	err := ps.client.post(ps.end, &pop, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of PopularityPrimitives")
+	}
+
+	return pop, nil
+}
+
+// Count returns the number of PopularityPrimitives available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which PopularityPrimitives to count.
+func (ps *PopularityPrimitiveService) Count(opts ...Option) (int, error) {
+	ct, err := ps.client.getCount(ps.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count PopularityPrimitives")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB PopularityPrimitive object.
+func (ps *PopularityPrimitiveService) Fields() ([]string, error) {
+	f, err := ps.client.getFields(ps.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get PopularityPrimitive fields")
+	}
+
+	return f, nil
+}
+
+// PopularGame contains a Game along with its popularity value
+// according to a particular PopularityType.
+type PopularGame struct {
+	Game  *Game   `json:"game"`
+	Value float64 `json:"value"`
+}
+
+// TopGames returns the provided number of most popular Games according to
+// the PopularityType identified by the provided IGDB ID, in descending order
+// of popularity. The number must be between 1 and 500. Provide the SetFilter
+// functional option if you need to narrow down which PopularityPrimitives to
+// consider. Primitives referencing Games that cannot be found are skipped. If
+// no Games can be found, an error is returned.
+func (ps *PopularityPrimitiveService) TopGames(popType int, n int, opts ...Option) ([]*PopularGame, error) {
+	if popType < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var pop []*PopularityPrimitive
+
+	opts = append(opts,
+		SetFields("game_id", "value"),
+		SetFilter("popularity_type", OpEquals, strconv.Itoa(popType)),
+		SetOrder("value", OrderDescending),
+		SetLimit(n),
+	)
+	err := ps.client.post(ps.end, &pop, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get top Games for PopularityType with ID %v", popType)
+	}
+
+	ids := make([]int, len(pop))
+	for i, p := range pop {
+		ids[i] = p.GameID
+	}
+
+	found, err := ps.client.getByIDs(EndpointGame, ids)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get top Games for PopularityType with ID %v", popType)
+	}
+
+	top := make([]*PopularGame, 0, len(pop))
+	for _, p := range pop {
+		if g, ok := found[p.GameID]; ok {
+			top = append(top, &PopularGame{Game: g.Interface().(*Game), Value: p.Value})
+		}
+	}
+
+	if len(top) == 0 {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get top Games for PopularityType with ID %v", popType)
+	}
+
+	return top, nil
+}
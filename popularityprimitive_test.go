@@ -0,0 +1,267 @@
+package igdb
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const (
+	testPopularityPrimitiveGet  string = "test_data/popularityprimitive_get.json"
+	testPopularityPrimitiveList string = "test_data/popularityprimitive_list.json"
+)
+
+func TestPopularityPrimitiveService_Get(t *testing.T) {
+	f, err := ioutil.ReadFile(testPopularityPrimitiveGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PopularityPrimitive, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                    string
+		file                    string
+		id                      int
+		opts                    []Option
+		wantPopularityPrimitive *PopularityPrimitive
+		wantErr                 error
+	}{
+		{"Valid response", testPopularityPrimitiveGet, 1000, []Option{SetFields("name")}, init[0], nil},
+		{"Invalid ID", testFileEmpty, -1, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, 1000, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, 1000, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, 0, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			pop, err := c.PopularityPrimitives.Get(test.id, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pop, test.wantPopularityPrimitive) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pop, test.wantPopularityPrimitive)
+			}
+		})
+	}
+}
+
+func TestPopularityPrimitiveService_List(t *testing.T) {
+	f, err := ioutil.ReadFile(testPopularityPrimitiveList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PopularityPrimitive, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name                     string
+		file                     string
+		ids                      []int
+		opts                     []Option
+		wantPopularityPrimitives []*PopularityPrimitive
+		wantErr                  error
+	}{
+		{"Valid response", testPopularityPrimitiveList, []int{1000, 1001, 1002, 1003, 1004}, []Option{SetLimit(5)}, init, nil},
+		{"Zero IDs", testFileEmpty, nil, nil, nil, ErrEmptyIDs},
+		{"Invalid ID", testFileEmpty, []int{-500}, nil, nil, ErrNegativeID},
+		{"Empty response", testFileEmpty, []int{1000, 1001, 1002, 1003, 1004}, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []int{1000, 1001, 1002, 1003, 1004}, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, []int{0, 9999999}, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			pop, err := c.PopularityPrimitives.List(test.ids, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pop, test.wantPopularityPrimitives) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pop, test.wantPopularityPrimitives)
+			}
+		})
+	}
+}
+
+func TestPopularityPrimitiveService_Index(t *testing.T) {
+	f, err := ioutil.ReadFile(testPopularityPrimitiveList)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*PopularityPrimitive, 0)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                     string
+		file                     string
+		opts                     []Option
+		wantPopularityPrimitives []*PopularityPrimitive
+		wantErr                  error
+	}{
+		{"Valid response", testPopularityPrimitiveList, []Option{SetLimit(5)}, init, nil},
+		{"Empty response", testFileEmpty, nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			pop, err := c.PopularityPrimitives.Index(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(pop, test.wantPopularityPrimitives) {
+				t.Errorf("got: <%v>, \nwant: <%v>", pop, test.wantPopularityPrimitives)
+			}
+		})
+	}
+}
+
+func TestPopularityPrimitiveService_Count(t *testing.T) {
+	var tests = []struct {
+		name      string
+		resp      string
+		opts      []Option
+		wantCount int
+		wantErr   error
+	}{
+		{"Happy path", `{"count": 100}`, []Option{SetFilter("hypes", OpGreaterThan, "75")}, 100, nil},
+		{"Empty response", "", nil, 0, errInvalidJSON},
+		{"Invalid option", "", []Option{SetLimit(-99999)}, 0, ErrOutOfRange},
+		{"No results", "[]", nil, 0, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			count, err := c.PopularityPrimitives.Count(test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if count != test.wantCount {
+				t.Fatalf("got: <%v>, want: <%v>", count, test.wantCount)
+
+			}
+		})
+	}
+}
+
+func TestPopularityPrimitiveService_Fields(t *testing.T) {
+	var tests = []struct {
+		name       string
+		resp       string
+		wantFields []string
+		wantErr    error
+	}{
+		{"Happy path", `["name", "slug", "url"]`, []string{"url", "slug", "name"}, nil},
+		{"Asterisk", `["*"]`, []string{"*"}, nil},
+		{"Empty response", "", nil, errInvalidJSON},
+		{"No results", "[]", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(http.StatusOK, test.resp)
+			defer ts.Close()
+
+			fields, err := c.PopularityPrimitives.Fields()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !equalSlice(fields, test.wantFields) {
+				t.Fatalf("Expected fields '%v', got '%v'", test.wantFields, fields)
+			}
+		})
+	}
+}
+
+func TestPopularityPrimitiveService_TopGames(t *testing.T) {
+	var tests = []struct {
+		name     string
+		prims    string
+		popType  int
+		n        int
+		wantIDs  []int
+		wantVals []float64
+		wantErr  error
+	}{
+		{
+			"Valid response",
+			`[{"id": 1, "game_id": 119133, "value": 0.04}, {"id": 2, "game_id": 404, "value": 0.03}, {"id": 3, "game_id": 7346, "value": 0.02}]`,
+			1,
+			3,
+			[]int{119133, 7346},
+			[]float64{0.04, 0.02},
+			nil,
+		},
+		{"Invalid type", "[]", -1, 3, nil, nil, ErrNegativeID},
+		{"Invalid number", "[]", 1, 0, nil, nil, ErrOutOfRange},
+		{"Too many", "[]", 1, 501, nil, nil, ErrOutOfRange},
+		{"No results", "[]", 1, 3, nil, nil, ErrNoResults},
+		{"No games", `[{"id": 2, "game_id": 404, "value": 0.03}]`, 1, 3, nil, nil, ErrNoResults},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRoutes(map[string]string{
+				"/popularity_primitives/": test.prims,
+				"/games/":                 `[{"id": 7346, "name": "Breath of the Wild"}, {"id": 119133, "name": "Elden Ring"}]`,
+			})
+			defer ts.Close()
+
+			top, err := c.PopularityPrimitives.TopGames(test.popType, test.n)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			var ids []int
+			var vals []float64
+			for _, p := range top {
+				ids = append(ids, p.Game.ID)
+				vals = append(vals, p.Value)
+			}
+
+			if !reflect.DeepEqual(ids, test.wantIDs) || !reflect.DeepEqual(vals, test.wantVals) {
+				t.Errorf("got: <%v, %v>, want: <%v, %v>", ids, vals, test.wantIDs, test.wantVals)
+			}
+		})
+	}
+}
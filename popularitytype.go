@@ -0,0 +1,116 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct PopularityType -add-tags json -w
+
+// PopularityType represents a kind of popularity measurement such as
+// visits, want to play, or playing.
+// For more information visit: https://api-docs.igdb.com/#popularity-type
+type PopularityType struct {
+	Presence
+	Extras
+	ID                       int       `json:"id"`
+	Checksum                 string    `json:"checksum"`
+	CreatedAt                Timestamp `json:"created_at"`
+	ExternalPopularitySource int       `json:"external_popularity_source"`
+	Name                     string    `json:"name"`
+	PopularitySource         int       `json:"popularity_source"`
+	UpdatedAt                Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the PopularityType and
+// records which of its fields were present or unrecognized.
+func (p *PopularityType) UnmarshalJSON(b []byte) error {
+	type popularityType PopularityType
+	return decodeModel(b, (*popularityType)(p), &p.Presence, &p.Extras)
+}
+
+// PopularityTypeService handles all the API calls for the IGDB PopularityType endpoint.
+type PopularityTypeService service
+
+// Get returns a single PopularityType identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any PopularityTypes, an error is returned.
+func (ps *PopularityTypeService) Get(id int, opts ...Option) (*PopularityType, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var typ []*PopularityType
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ps.client.post(ps.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PopularityType with ID %v", id)
+	}
+
+	return typ[0], nil
+}
+
+// List returns a list of PopularityTypes identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a PopularityType is ignored. If none of the IDs
+// match a PopularityType, an error is returned.
+func (ps *PopularityTypeService) List(ids []int, opts ...Option) ([]*PopularityType, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var typ []*PopularityType
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ps.client.post(ps.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get PopularityTypes with IDs %v", ids)
+	}
+
+	return typ, nil
+}
+
+// Index returns an index of PopularityTypes based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no PopularityTypes can
+// be found using the provided options, an error is returned.
+func (ps *PopularityTypeService) Index(opts ...Option) ([]*PopularityType, error) {
+	var typ []*PopularityType
+
+	err := ps.client.post(ps.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of PopularityTypes")
+	}
+
+	return typ, nil
+}
+
+// Count returns the number of PopularityTypes available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which PopularityTypes to count.
+func (ps *PopularityTypeService) Count(opts ...Option) (int, error) {
+	ct, err := ps.client.getCount(ps.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count PopularityTypes")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB PopularityType object.
+func (ps *PopularityTypeService) Fields() ([]string, error) {
+	f, err := ps.client.getFields(ps.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get PopularityType fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,106 @@
+package igdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Presence records which fields of an IGDB object were present in the API
+// response it was decoded from. Presence is embedded in every IGDB object so
+// that a field which was never retrieved (e.g. a Game retrieved without the
+// cover field) can be told apart from a field whose value is simply zero.
+//
+// The field names are held by pointer so that the IGDB objects embedding
+// Presence can still be compared with the == operator.
+type Presence struct {
+	present *map[string]bool
+}
+
+// fields returns the names of the present fields as a set.
+func (p Presence) fields() map[string]bool {
+	if p.present == nil {
+		return nil
+	}
+
+	return *p.present
+}
+
+// Has returns true if the field with the provided JSON name (e.g. "cover")
+// was present in the response the object was decoded from. Objects that were
+// not decoded from a response report every field as missing.
+func (p Presence) Has(field string) bool {
+	return p.fields()[field]
+}
+
+// Present returns the JSON names of every field that was present in the
+// response the object was decoded from, in ascending order.
+func (p Presence) Present() []string {
+	f := make([]string, 0, len(p.fields()))
+	for k := range p.fields() {
+		f = append(f, k)
+	}
+	sort.Strings(f)
+
+	return f
+}
+
+// nullJSON is the JSON representation of a null value.
+var nullJSON = []byte("null")
+
+// decodeModel decodes the provided JSON object into v, records the names of
+// the fields present in the object into p, and retains every field that v has
+// no struct field for in e. The value v must be a pointer to a struct type
+// without its own UnmarshalJSON method, typically a local alias of the model
+// type being decoded. Fields with a null value are not considered present.
+//
+// The object is only parsed once; each of its values is then decoded into
+// the struct field with the matching JSON name. As with json.Unmarshal, a
+// value that cannot be decoded does not stop the remaining values from being
+// decoded, and the first such error is returned.
+func decodeModel(b []byte, v interface{}, p *Presence, e *Extras) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	obj := reflect.ValueOf(v).Elem()
+	known := knownFields(obj.Type())
+
+	present := make(map[string]bool, len(raw))
+	var extra map[string]json.RawMessage
+	var firstErr error
+	for k, val := range raw {
+		f, ok := known[strings.ToLower(k)]
+		if !ok {
+			if extra == nil {
+				extra = make(map[string]json.RawMessage)
+			}
+			extra[k] = val
+		}
+
+		if bytes.Equal(bytes.TrimSpace(val), nullJSON) {
+			continue
+		}
+		present[k] = true
+
+		if !ok {
+			continue
+		}
+
+		err := json.Unmarshal(val, obj.FieldByIndex(f.index).Addr().Interface())
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	p.present = &present
+	e.extra = nil
+	if extra != nil {
+		e.extra = &extra
+	}
+
+	return firstErr
+}
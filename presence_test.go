@@ -0,0 +1,133 @@
+package igdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPresence_Has(t *testing.T) {
+	var tests = []struct {
+		name        string
+		json        string
+		wantPresent []string
+		wantMissing []string
+	}{
+		{"Zero fields", `{}`, []string{}, []string{"id", "onlinecoop"}},
+		{"False boolean field", `{"id": 1, "onlinecoop": false}`, []string{"id", "onlinecoop"}, []string{"onlinemax"}},
+		{"Zero integer field", `{"onlinemax": 0}`, []string{"onlinemax"}, []string{"onlinecoop"}},
+		{"Null field", `{"id": 1, "onlinecoop": null}`, []string{"id"}, []string{"onlinecoop"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var m MultiplayerMode
+			if err := json.Unmarshal([]byte(test.json), &m); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, f := range test.wantPresent {
+				if !m.Has(f) {
+					t.Errorf("got: <missing %s>, want: <present %s>", f, f)
+				}
+			}
+
+			for _, f := range test.wantMissing {
+				if m.Has(f) {
+					t.Errorf("got: <present %s>, want: <missing %s>", f, f)
+				}
+			}
+
+			if !reflect.DeepEqual(m.Present(), test.wantPresent) {
+				t.Errorf("got: <%v>, want: <%v>", m.Present(), test.wantPresent)
+			}
+		})
+	}
+}
+
+func TestPresence_Unset(t *testing.T) {
+	g := Game{Cover: 5}
+	if g.Has("cover") {
+		t.Errorf("got: <present cover>, want: <missing cover>")
+	}
+
+	if len(g.Present()) != 0 {
+		t.Errorf("got: <%v>, want: <%v>", g.Present(), []string{})
+	}
+}
+
+func TestPresence_Response(t *testing.T) {
+	ts, c := testServerString(http.StatusOK, `[{"id": 7346, "name": "The Legend of Zelda: Breath of the Wild", "cover": 0}]`)
+	defer ts.Close()
+
+	g, err := c.Games.Get(7346, SetFields("name", "cover"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g.Name != "The Legend of Zelda: Breath of the Wild" {
+		t.Errorf("got: <%v>, want: <%v>", g.Name, "The Legend of Zelda: Breath of the Wild")
+	}
+
+	if !g.Has("cover") || g.Has("summary") {
+		t.Errorf("got: <%v>, want: <%v>", g.Present(), []string{"cover", "id", "name"})
+	}
+}
+
+func TestPresence_Embedded(t *testing.T) {
+	f, err := ioutil.ReadFile(testCoverGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cov []*Cover
+	if err = json.Unmarshal(f, &cov); err != nil {
+		t.Fatal(err)
+	}
+
+	if cov[0].ImageID == "" {
+		t.Errorf("got: <empty image_id>, want: <non-empty image_id>")
+	}
+
+	if !cov[0].Has("image_id") || !cov[0].Has("game") {
+		t.Errorf("got: <%v>, want: <image_id and game present>", cov[0].Present())
+	}
+}
+
+func TestPresence_Comparable(t *testing.T) {
+	if (Cover{}) != (Cover{}) {
+		t.Errorf("got: <unequal>, want: <equal> zero Covers")
+	}
+
+	var c Cover
+	if err := json.Unmarshal([]byte(`{"id": 1, "image_id": "abc", "new_field": true}`), &c); err != nil {
+		t.Fatal(err)
+	}
+
+	d := c
+	if c != d {
+		t.Errorf("got: <unequal>, want: <equal> copies of decoded Cover")
+	}
+}
+
+func TestDecodeModel(t *testing.T) {
+	var g Game
+	err := json.Unmarshal([]byte(`{"id": 1, "name": 5, "Slug": "portal", "rating": 90.5, "new_field": [1]}`), &g)
+	if _, ok := err.(*json.UnmarshalTypeError); !ok {
+		t.Errorf("got: <%v>, want: <type error for name>", err)
+	}
+
+	if g.ID != 1 || g.Slug != "portal" || g.Rating != 90.5 {
+		t.Errorf("got: <%v>, want: <remaining fields decoded>", g)
+	}
+
+	if !g.Has("name") || !g.Has("Slug") {
+		t.Errorf("got: <%v>, want: <name and Slug present>", g.Present())
+	}
+
+	if raw, ok := g.Extra("new_field"); !ok || string(raw) != "[1]" {
+		t.Errorf("got: <%s>, want: <%s>", raw, "[1]")
+	}
+}
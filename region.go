@@ -0,0 +1,141 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/blank"
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct Region -add-tags json -w
+
+// Region represents a geographical region such as a continent or a
+// locale that games can be localized for.
+// For more information visit: https://api-docs.igdb.com/#region
+type Region struct {
+	Presence
+	Extras
+	ID         int       `json:"id"`
+	Category   string    `json:"category"`
+	Checksum   string    `json:"checksum"`
+	CreatedAt  Timestamp `json:"created_at"`
+	Identifier string    `json:"identifier"`
+	Name       string    `json:"name"`
+	UpdatedAt  Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the Region and
+// records which of its fields were present or unrecognized.
+func (r *Region) UnmarshalJSON(b []byte) error {
+	type region Region
+	return decodeModel(b, (*region)(r), &r.Presence, &r.Extras)
+}
+
+// RegionService handles all the API calls for the IGDB Region endpoint.
+type RegionService service
+
+// Get returns a single Region identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any Regions, an error is returned.
+func (rs *RegionService) Get(id int, opts ...Option) (*Region, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var reg []*Region
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := rs.client.post(rs.end, &reg, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Region with ID %v", id)
+	}
+
+	return reg[0], nil
+}
+
+// List returns a list of Regions identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a Region is ignored. If none of the IDs
+// match a Region, an error is returned.
+func (rs *RegionService) List(ids []int, opts ...Option) ([]*Region, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var reg []*Region
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := rs.client.post(rs.end, &reg, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Regions with IDs %v", ids)
+	}
+
+	return reg, nil
+}
+
+// Index returns an index of Regions based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no Regions can
+// be found using the provided options, an error is returned.
+func (rs *RegionService) Index(opts ...Option) ([]*Region, error) {
+	var reg []*Region
+
+	err := rs.client.post(rs.end, &reg, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of Regions")
+	}
+
+	return reg, nil
+}
+
+// Count returns the number of Regions available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which Regions to count.
+func (rs *RegionService) Count(opts ...Option) (int, error) {
+	ct, err := rs.client.getCount(rs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count Regions")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB Region object.
+func (rs *RegionService) Fields() ([]string, error) {
+	f, err := rs.client.getFields(rs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get Region fields")
+	}
+
+	return f, nil
+}
+
+// byIdentifier returns the Region with the provided identifier (e.g. "ja-JP")
+// or name (e.g. "Japan"), ignoring case. If none match, an error is returned.
+func (rs *RegionService) byIdentifier(ident string) (*Region, error) {
+	if blank.Is(ident) {
+		return nil, ErrEmptyQry
+	}
+
+	var reg []*Region
+	err := rs.client.postAll(rs.end, &reg, SetFields("identifier", "name"))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get Regions")
+	}
+
+	ident = strings.TrimSpace(ident)
+	for _, r := range reg {
+		if strings.EqualFold(ident, r.Identifier) || strings.EqualFold(ident, r.Name) {
+			return r, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrUnknownRegion, "cannot find Region '%s'", ident)
+}
@@ -12,22 +12,36 @@ import (
 // Used to dig deeper into release dates, platforms, and versions.
 // For more information visit: https://api-docs.igdb.com/#release-date
 type ReleaseDate struct {
-	ID        int            `json:"id"`
-	Category  DateCategory   `json:"category"`
-	CreatedAt int            `json:"created_at"`
-	Date      int            `json:"date"`
-	Game      int            `json:"game"`
-	Human     string         `json:"human"`
-	M         int            `json:"m"`
-	Platform  int            `json:"platform"`
-	Region    RegionCategory `json:"region"`
-	UpdatedAt int            `json:"updated_at"`
-	Y         int            `json:"y"`
+	Presence
+	Extras
+	ID         int            `json:"id"`
+	Category   DateCategory   `json:"category"`
+	CreatedAt  Timestamp      `json:"created_at"`
+	Date       Timestamp      `json:"date"`
+	DateFormat int            `json:"date_format"`
+	Game       int            `json:"game"`
+	Human      string         `json:"human"`
+	M          int            `json:"m"`
+	Platform   int            `json:"platform"`
+	Status     int            `json:"status"`
+	Region     RegionCategory `json:"region"`
+	UpdatedAt  Timestamp      `json:"updated_at"`
+	Y          int            `json:"y"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the ReleaseDate and
+// records which of its fields were present or unrecognized.
+func (r *ReleaseDate) UnmarshalJSON(b []byte) error {
+	type releaseDate ReleaseDate
+	return decodeModel(b, (*releaseDate)(r), &r.Presence, &r.Extras)
 }
 
 //go:generate stringer -type=DateCategory,RegionCategory
 
 // DateCategory specifies the format of a release date.
+//
+// The IGDB v4 deprecates the category field of a ReleaseDate in favor
+// of the date_format field, which holds a Date Format ID instead.
 type DateCategory int
 
 // Expected DateCategory enums from the IGDB.
@@ -0,0 +1,115 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct ReleaseDateStatusLookup -add-tags json -w
+
+// ReleaseDateStatusLookup represents the status of a particular release date
+// such as an early access or full release.
+// For more information visit: https://api-docs.igdb.com/#release-date-status
+type ReleaseDateStatusLookup struct {
+	Presence
+	Extras
+	ID          int       `json:"id"`
+	Checksum    string    `json:"checksum"`
+	CreatedAt   Timestamp `json:"created_at"`
+	Description string    `json:"description"`
+	Name        string    `json:"name"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the ReleaseDateStatusLookup and
+// records which of its fields were present or unrecognized.
+func (r *ReleaseDateStatusLookup) UnmarshalJSON(b []byte) error {
+	type releaseDateStatusLookup ReleaseDateStatusLookup
+	return decodeModel(b, (*releaseDateStatusLookup)(r), &r.Presence, &r.Extras)
+}
+
+// ReleaseDateStatusLookupService handles all the API calls for the IGDB ReleaseDateStatusLookup endpoint.
+type ReleaseDateStatusLookupService service
+
+// Get returns a single ReleaseDateStatusLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any ReleaseDateStatuses, an error is returned.
+func (rs *ReleaseDateStatusLookupService) Get(id int, opts ...Option) (*ReleaseDateStatusLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var stat []*ReleaseDateStatusLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := rs.client.post(rs.end, &stat, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get ReleaseDateStatusLookup with ID %v", id)
+	}
+
+	return stat[0], nil
+}
+
+// List returns a list of ReleaseDateStatuses identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a ReleaseDateStatusLookup is ignored. If none of the IDs
+// match a ReleaseDateStatusLookup, an error is returned.
+func (rs *ReleaseDateStatusLookupService) List(ids []int, opts ...Option) ([]*ReleaseDateStatusLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var stat []*ReleaseDateStatusLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := rs.client.post(rs.end, &stat, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get ReleaseDateStatuses with IDs %v", ids)
+	}
+
+	return stat, nil
+}
+
+// Index returns an index of ReleaseDateStatuses based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no ReleaseDateStatuses can
+// be found using the provided options, an error is returned.
+func (rs *ReleaseDateStatusLookupService) Index(opts ...Option) ([]*ReleaseDateStatusLookup, error) {
+	var stat []*ReleaseDateStatusLookup
+
+	err := rs.client.post(rs.end, &stat, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of ReleaseDateStatuses")
+	}
+
+	return stat, nil
+}
+
+// Count returns the number of ReleaseDateStatuses available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which ReleaseDateStatuses to count.
+func (rs *ReleaseDateStatusLookupService) Count(opts ...Option) (int, error) {
+	ct, err := rs.client.getCount(rs.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count ReleaseDateStatuses")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB ReleaseDateStatusLookup object.
+func (rs *ReleaseDateStatusLookupService) Fields() ([]string, error) {
+	f, err := rs.client.getFields(rs.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get ReleaseDateStatusLookup fields")
+	}
+
+	return f, nil
+}
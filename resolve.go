@@ -0,0 +1,455 @@
+package igdb
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedType occurs when an object is used that is not
+// a pointer to a supported IGDB object type.
+var ErrUnsupportedType = errors.New("object is not a pointer to a supported IGDB object type")
+
+// references maps the endpoint of each IGDB object type to the JSON tags of
+// the fields that reference other IGDB objects by ID and the endpoints of
+// the objects those fields reference.
+var references = map[endpoint]map[string]endpoint{
+	EndpointAgeRating: {
+		"content_descriptions": EndpointAgeRatingContent,
+		"organization":         EndpointAgeRatingOrganization,
+		"rating_category":      EndpointAgeRatingCategory,
+	},
+	EndpointAgeRatingCategory: {
+		"organization": EndpointAgeRatingOrganization,
+	},
+	EndpointAlternativeName: {
+		"game": EndpointGame,
+	},
+	EndpointArtwork: {
+		"game": EndpointGame,
+	},
+	EndpointCharacter: {
+		"character_gender":  EndpointCharacterGender,
+		"character_species": EndpointCharacterSpecies,
+		"games":             EndpointGame,
+		"mug_shot":          EndpointCharacterMugshot,
+	},
+	EndpointCollection: {
+		"as_child_relations":  EndpointCollectionRelation,
+		"as_parent_relations": EndpointCollectionRelation,
+		"games":               EndpointGame,
+		"type":                EndpointCollectionType,
+	},
+	EndpointCollectionMembership: {
+		"collection": EndpointCollection,
+		"game":       EndpointGame,
+		"type":       EndpointCollectionMembershipType,
+	},
+	EndpointCollectionMembershipType: {
+		"allowed_collection_type": EndpointCollectionType,
+	},
+	EndpointCollectionRelation: {
+		"child_collection":  EndpointCollection,
+		"parent_collection": EndpointCollection,
+		"type":              EndpointCollectionRelationType,
+	},
+	EndpointCollectionRelationType: {
+		"allowed_child_type":  EndpointCollectionType,
+		"allowed_parent_type": EndpointCollectionType,
+	},
+	EndpointCompany: {
+		"changed_company_id": EndpointCompany,
+		"developed":          EndpointGame,
+		"logo":               EndpointCompanyLogo,
+		"parent":             EndpointCompany,
+		"published":          EndpointGame,
+		"websites":           EndpointCompanyWebsite,
+	},
+	EndpointCover: {
+		"game": EndpointGame,
+	},
+	EndpointEvent: {
+		"event_logo":     EndpointEventLogo,
+		"event_networks": EndpointEventNetwork,
+		"games":          EndpointGame,
+		"videos":         EndpointGameVideo,
+	},
+	EndpointEventLogo: {
+		"event": EndpointEvent,
+	},
+	EndpointEventNetwork: {
+		"event":        EndpointEvent,
+		"network_type": EndpointNetworkType,
+	},
+	EndpointExternalGame: {
+		"external_game_source": EndpointExternalGameSource,
+		"game":                 EndpointGame,
+	},
+	EndpointFranchise: {
+		"games": EndpointGame,
+	},
+	EndpointGame: {
+		"age_ratings":           EndpointAgeRating,
+		"alternative_names":     EndpointAlternativeName,
+		"artworks":              EndpointArtwork,
+		"bundles":               EndpointGame,
+		"collection":            EndpointCollection,
+		"collections":           EndpointCollection,
+		"cover":                 EndpointCover,
+		"dlcs":                  EndpointGame,
+		"expanded_games":        EndpointGame,
+		"expansions":            EndpointGame,
+		"external_games":        EndpointExternalGame,
+		"forks":                 EndpointGame,
+		"franchise":             EndpointFranchise,
+		"franchises":            EndpointFranchise,
+		"game_engines":          EndpointGameEngine,
+		"game_localizations":    EndpointGameLocalization,
+		"game_modes":            EndpointGameMode,
+		"game_status":           EndpointGameStatus,
+		"game_type":             EndpointGameType,
+		"genres":                EndpointGenre,
+		"involved_companies":    EndpointInvolvedCompany,
+		"keywords":              EndpointKeyword,
+		"language_supports":     EndpointLanguageSupport,
+		"multiplayer_modes":     EndpointMultiplayerMode,
+		"parent_game":           EndpointGame,
+		"platforms":             EndpointPlatform,
+		"player_perspectives":   EndpointPlayerPerspective,
+		"ports":                 EndpointGame,
+		"release_dates":         EndpointReleaseDate,
+		"remakes":               EndpointGame,
+		"remasters":             EndpointGame,
+		"screenshots":           EndpointScreenshot,
+		"similar_games":         EndpointGame,
+		"standalone_expansions": EndpointGame,
+		"themes":                EndpointTheme,
+		"version_parent":        EndpointGame,
+		"videos":                EndpointGameVideo,
+		"websites":              EndpointWebsite,
+	},
+	EndpointGameEngine: {
+		"companies": EndpointCompany,
+		"logo":      EndpointGameEngineLogo,
+		"platforms": EndpointPlatform,
+	},
+	EndpointGameLocalization: {
+		"cover":  EndpointCover,
+		"game":   EndpointGame,
+		"region": EndpointRegion,
+	},
+	EndpointGameTimeToBeat: {
+		"game_id": EndpointGame,
+	},
+	EndpointGameVersion: {
+		"features": EndpointGameVersionFeature,
+		"game":     EndpointGame,
+		"games":    EndpointGame,
+	},
+	EndpointGameVersionFeature: {
+		"values": EndpointGameVersionFeatureValue,
+	},
+	EndpointGameVersionFeatureValue: {
+		"game":         EndpointGame,
+		"game_feature": EndpointGameVersionFeature,
+	},
+	EndpointGameVideo: {
+		"game": EndpointGame,
+	},
+	EndpointInvolvedCompany: {
+		"company": EndpointCompany,
+		"game":    EndpointGame,
+	},
+	EndpointLanguageSupport: {
+		"game":                  EndpointGame,
+		"language":              EndpointLanguage,
+		"language_support_type": EndpointLanguageSupportType,
+	},
+	EndpointMultiplayerMode: {
+		"platform": EndpointPlatform,
+	},
+	EndpointNetworkType: {
+		"event_networks": EndpointEventNetwork,
+	},
+	EndpointPlatform: {
+		"platform_logo":  EndpointPlatformLogo,
+		"platform_type":  EndpointPlatformType,
+		"product_family": EndpointPlatformFamily,
+		"versions":       EndpointPlatformVersion,
+		"websites":       EndpointPlatformWebsite,
+	},
+	EndpointPlatformVersion: {
+		"companies":                      EndpointPlatformVersionCompany,
+		"main_manufacturer":              EndpointPlatformVersionCompany,
+		"platform_logo":                  EndpointPlatformLogo,
+		"platform_version_release_dates": EndpointPlatformVersionReleaseDate,
+	},
+	EndpointPlatformVersionCompany: {
+		"company": EndpointCompany,
+	},
+	EndpointPlatformVersionReleaseDate: {
+		"platform_version": EndpointPlatformVersion,
+	},
+	EndpointPopularityPrimitive: {
+		"game_id":         EndpointGame,
+		"popularity_type": EndpointPopularityType,
+	},
+	EndpointReleaseDate: {
+		"date_format": EndpointDateFormat,
+		"game":        EndpointGame,
+		"platform":    EndpointPlatform,
+		"status":      EndpointReleaseDateStatus,
+	},
+	EndpointScreenshot: {
+		"game": EndpointGame,
+	},
+	EndpointSearch: {
+		"character":  EndpointCharacter,
+		"collection": EndpointCollection,
+		"company":    EndpointCompany,
+		"game":       EndpointGame,
+		"platform":   EndpointPlatform,
+		"theme":      EndpointTheme,
+	},
+	EndpointWebsite: {
+		"type": EndpointWebsiteType,
+	},
+}
+
+// Node is an IGDB object within a graph of resolved references. Value holds
+// a pointer to the object itself (e.g. *Game) and Refs holds the Nodes of
+// the objects its resolved reference fields point to, keyed by the JSON tag
+// of the field (e.g. "genres").
+//
+// Every object appears in a graph only once. If two objects reference the
+// same object, or the references form a cycle, their Refs share the same
+// Node rather than retrieving or copying the object again.
+type Node struct {
+	Endpoint endpoint
+	ID       int
+	Value    interface{}
+	Refs     map[string][]*Node
+}
+
+// Ref returns the Nodes referenced by the field with the provided JSON tag,
+// in the order of the IDs held by the field. If the field was not resolved,
+// nil is returned.
+func (n *Node) Ref(field string) []*Node {
+	return n.Refs[field]
+}
+
+// Resolve retrieves the objects referenced by the provided reference paths of
+// the provided object and returns them as a graph rooted at the object. The
+// object must be a pointer to an IGDB object type (e.g. *Company). A path
+// names a reference field by its JSON tag (e.g. "developed") and can continue
+// into the fields of the referenced objects with the dot operator (e.g.
+// "developed.genres"). The provided object must have been retrieved with the
+// fields at the start of every path.
+func (c *Client) Resolve(obj interface{}, paths ...string) (*Node, error) {
+	v := reflect.ValueOf(obj)
+	if !v.IsValid() || v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil, errors.Wrap(ErrUnsupportedType, "cannot resolve nil or non-pointer object")
+	}
+
+	objs := reflect.MakeSlice(reflect.SliceOf(v.Type()), 1, 1)
+	objs.Index(0).Set(v)
+
+	nodes, err := c.ResolveAll(objs.Interface(), paths...)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes[0], nil
+}
+
+// ResolveAll works like Resolve for a slice of objects of the same IGDB object
+// type (e.g. []*Company). The references of every object are retrieved in
+// batches so that each related endpoint is only requested once per path
+// segment, regardless of the number of objects. The graph Nodes of the
+// provided objects are returned in the same order, skipping nil objects.
+func (c *Client) ResolveAll(objs interface{}, paths ...string) ([]*Node, error) {
+	v := reflect.ValueOf(objs)
+	if v.Kind() != reflect.Slice {
+		return nil, errors.Wrap(ErrUnsupportedType, "cannot resolve non-slice objects")
+	}
+
+	end, ok := typeEndpoint(v.Type().Elem())
+	if !ok {
+		return nil, errors.Wrapf(ErrUnsupportedType, "cannot resolve objects of type %v", v.Type().Elem())
+	}
+
+	tree, err := parsePaths(end, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &graph{client: c, nodes: make(map[nodeKey]*Node)}
+
+	var roots []*Node
+	for i := 0; i < v.Len(); i++ {
+		if v.Index(i).IsNil() {
+			continue
+		}
+		roots = append(roots, g.add(end, v.Index(i)))
+	}
+
+	if err := g.expand(end, roots, tree); err != nil {
+		return nil, err
+	}
+
+	return roots, nil
+}
+
+// pathTree is a set of reference paths organized by their shared segments.
+type pathTree map[string]pathTree
+
+// parsePaths organizes the provided reference paths into a pathTree and
+// checks that every segment names a reference field of the object type
+// it is applied to, starting from the provided endpoint.
+func parsePaths(end endpoint, paths []string) (pathTree, error) {
+	tree := make(pathTree)
+	for _, p := range paths {
+		if blank.Is(p) {
+			return nil, ErrEmptyFields
+		}
+
+		cur, curEnd := tree, end
+		for _, seg := range strings.Split(p, ".") {
+			next, ok := references[curEnd][seg]
+			if !ok {
+				return nil, errors.Wrapf(ErrUnknownRelation, "cannot resolve '%s' of '%s' endpoint in path '%s'", seg, curEnd, p)
+			}
+
+			if cur[seg] == nil {
+				cur[seg] = make(pathTree)
+			}
+			cur, curEnd = cur[seg], next
+		}
+	}
+
+	return tree, nil
+}
+
+// nodeKey identifies a single IGDB object.
+type nodeKey struct {
+	end endpoint
+	id  int
+}
+
+// graph holds every Node resolved for a single call to ResolveAll.
+type graph struct {
+	client *Client
+	nodes  map[nodeKey]*Node
+}
+
+// add returns the Node of the provided object pointer from the provided
+// endpoint, creating and storing it in the graph if it is not yet present.
+// Objects without an ID, such as SearchResults, cannot be referenced by other
+// objects, so they are given their own Node without being stored.
+func (g *graph) add(end endpoint, obj reflect.Value) *Node {
+	idField := obj.Elem().FieldByName("ID")
+	if !idField.IsValid() {
+		return &Node{Endpoint: end, Value: obj.Interface(), Refs: make(map[string][]*Node)}
+	}
+
+	id := int(idField.Int())
+	key := nodeKey{end: end, id: id}
+	if n, ok := g.nodes[key]; ok {
+		return n
+	}
+
+	n := &Node{Endpoint: end, ID: id, Value: obj.Interface(), Refs: make(map[string][]*Node)}
+	g.nodes[key] = n
+
+	return n
+}
+
+// expand resolves the fields of the provided tree for the provided Nodes, all
+// of which belong to the provided endpoint, and continues into the subtrees
+// with the referenced Nodes. Objects already in the graph are not retrieved
+// again.
+func (g *graph) expand(end endpoint, nodes []*Node, tree pathTree) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(tree))
+	for f := range tree {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+
+	for _, f := range fields {
+		target := references[end][f]
+
+		var missing []int
+		for _, n := range nodes {
+			if _, ok := n.Refs[f]; ok {
+				continue
+			}
+
+			for _, id := range referencedIDs(fieldByTag(reflect.ValueOf(n.Value), f)) {
+				if _, ok := g.nodes[nodeKey{end: target, id: id}]; !ok {
+					missing = append(missing, id)
+				}
+			}
+		}
+
+		if len(missing) > 0 {
+			found, err := g.client.getByIDs(target, missing)
+			if err != nil {
+				return errors.Wrapf(err, "cannot resolve '%s' of '%s' endpoint", f, end)
+			}
+
+			for _, obj := range found {
+				g.add(target, obj)
+			}
+		}
+
+		var next []*Node
+		queued := make(map[*Node]bool)
+		for _, n := range nodes {
+			if _, ok := n.Refs[f]; !ok {
+				refs := []*Node{}
+				for _, id := range referencedIDs(fieldByTag(reflect.ValueOf(n.Value), f)) {
+					if ref, ok := g.nodes[nodeKey{end: target, id: id}]; ok {
+						refs = append(refs, ref)
+					}
+				}
+				n.Refs[f] = refs
+			}
+
+			for _, ref := range n.Refs[f] {
+				if !queued[ref] {
+					queued[ref] = true
+					next = append(next, ref)
+				}
+			}
+		}
+
+		if err := g.expand(target, next, tree[f]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// typeEndpoint returns the endpoint of the IGDB object type pointed to by
+// the provided pointer type.
+func typeEndpoint(t reflect.Type) (endpoint, bool) {
+	if t.Kind() != reflect.Ptr {
+		return "", false
+	}
+
+	for end, mt := range modelTypes {
+		if mt == t.Elem() {
+			return end, true
+		}
+	}
+
+	return "", false
+}
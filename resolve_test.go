@@ -0,0 +1,174 @@
+package igdb
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestClient_Resolve(t *testing.T) {
+	routes := map[string]string{
+		"/games/":  `[{"id": 10, "name": "Portal", "genres": [5]}, {"id": 20, "name": "Half-Life", "genres": [5, 9]}]`,
+		"/genres/": `[{"id": 5, "name": "Shooter"}, {"id": 9, "name": "Puzzle"}]`,
+	}
+
+	ts, c := testServerRoutes(routes)
+	defer ts.Close()
+
+	com := &Company{ID: 56, Name: "Valve", Developed: []int{20, 10}, Published: []int{10}}
+
+	n, err := c.Resolve(com, "developed.genres", "published")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n.Value != com || n.Endpoint != EndpointCompany || n.ID != 56 {
+		t.Errorf("got: <%v>, want: <node of company 56>", n)
+	}
+
+	dev := n.Ref("developed")
+	if len(dev) != 2 || dev[0].ID != 20 || dev[1].ID != 10 {
+		t.Fatalf("got: <%v>, want: <games 20 and 10>", dev)
+	}
+
+	if g, ok := dev[0].Value.(*Game); !ok || g.Name != "Half-Life" {
+		t.Errorf("got: <%v>, want: <Half-Life>", dev[0].Value)
+	}
+
+	if pub := n.Ref("published"); len(pub) != 1 || pub[0] != dev[1] {
+		t.Errorf("got: <%v>, want: <shared node of game 10>", pub)
+	}
+
+	if gen := dev[0].Ref("genres"); len(gen) != 2 || gen[1].Value.(*Genre).Name != "Puzzle" {
+		t.Errorf("got: <%v>, want: <genres 5 and 9>", gen)
+	}
+
+	if dev[0].Ref("genres")[0] != dev[1].Ref("genres")[0] {
+		t.Errorf("got: <separate genre nodes>, want: <shared genre node>")
+	}
+
+	if n.Ref("websites") != nil {
+		t.Errorf("got: <%v>, want: <nil>", n.Ref("websites"))
+	}
+}
+
+func TestClient_ResolveCycle(t *testing.T) {
+	routes := map[string]string{
+		"/games/": `[{"id": 1, "similar_games": [2]}, {"id": 2, "similar_games": [1]}]`,
+	}
+
+	ts, c := testServerRoutes(routes)
+	defer ts.Close()
+
+	g := &Game{ID: 1, SimilarGames: []int{2}}
+
+	n, err := c.Resolve(g, "similar_games.similar_games.similar_games")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sim := n.Ref("similar_games")
+	if len(sim) != 1 || sim[0].ID != 2 {
+		t.Fatalf("got: <%v>, want: <game 2>", sim)
+	}
+
+	back := sim[0].Ref("similar_games")
+	if len(back) != 1 || back[0] != n {
+		t.Errorf("got: <%v>, want: <root node>", back)
+	}
+
+	if len(n.Ref("similar_games")) != 1 {
+		t.Errorf("got: <%v>, want: <single reference>", n.Ref("similar_games"))
+	}
+}
+
+func TestClient_ResolveSearchResult(t *testing.T) {
+	ts, c := testServerRoutes(map[string]string{
+		"/games/": `[{"id": 7, "name": "Doom", "genres": [5]}]`,
+	})
+	defer ts.Close()
+
+	res := []*SearchResult{{Name: "Doom", Game: 7}, {Name: "Doom", Game: 7}}
+
+	nodes, err := c.ResolveAll(res, "game")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(nodes) != 2 || nodes[0] == nodes[1] || nodes[0].Value != res[0] || nodes[1].Value != res[1] {
+		t.Fatalf("got: <%v>, want: <separate nodes of both results>", nodes)
+	}
+
+	for _, n := range nodes {
+		if g := n.Ref("game"); len(g) != 1 || g[0].ID != 7 || g[0].Value.(*Game).Name != "Doom" {
+			t.Errorf("got: <%v>, want: <game 7>", g)
+		}
+	}
+
+	n, err := c.Resolve(&SearchResult{Game: 7}, "game")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g := n.Ref("game"); len(g) != 1 || g[0].ID != 7 {
+		t.Errorf("got: <%v>, want: <game 7>", g)
+	}
+}
+
+func TestClient_ResolveErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		obj     interface{}
+		paths   []string
+		wantErr error
+	}{
+		{"Nil object", http.StatusOK, nil, []string{"genres"}, ErrUnsupportedType},
+		{"Nil pointer", http.StatusOK, (*Game)(nil), []string{"genres"}, ErrUnsupportedType},
+		{"Non-pointer object", http.StatusOK, Game{}, []string{"genres"}, ErrUnsupportedType},
+		{"Unsupported type", http.StatusOK, &testResultPlaceholder{}, []string{"genres"}, ErrUnsupportedType},
+		{"Non-reference field", http.StatusOK, &Game{}, []string{"name"}, ErrUnknownRelation},
+		{"Non-reference subfield", http.StatusOK, &Game{}, []string{"genres.slug"}, ErrUnknownRelation},
+		{"Empty path", http.StatusOK, &Game{}, []string{" "}, ErrEmptyFields},
+		{"Bad status", http.StatusBadRequest, &Game{Genres: []int{5}}, []string{"genres"}, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerString(test.status, "")
+			defer ts.Close()
+
+			_, err := c.Resolve(test.obj, test.paths...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_ResolveAll(t *testing.T) {
+	ts, c := testServerRoutes(map[string]string{
+		"/platforms/": `[{"id": 6, "name": "PC (Microsoft Windows)"}]`,
+	})
+	defer ts.Close()
+
+	rds := []*ReleaseDate{{ID: 1, Platform: 6}, nil, {ID: 2, Platform: 6}}
+
+	nodes, err := c.ResolveAll(rds, "platform")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(nodes) != 2 {
+		t.Fatalf("got: <%v> nodes, want: <%v> nodes", len(nodes), 2)
+	}
+
+	if nodes[0].Ref("platform")[0] != nodes[1].Ref("platform")[0] {
+		t.Errorf("got: <separate platform nodes>, want: <shared platform node>")
+	}
+
+	if _, err = c.ResolveAll(rds[0], "platform"); errors.Cause(err) != ErrUnsupportedType {
+		t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), ErrUnsupportedType)
+	}
+}
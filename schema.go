@@ -0,0 +1,168 @@
+package igdb
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownEndpoint occurs when an endpoint is used that has
+// no corresponding IGDB object type in this package.
+var ErrUnknownEndpoint = errors.New("endpoint has no corresponding object type")
+
+// SchemaReport describes the differences between the fields an IGDB endpoint
+// reports through its Fields function and the fields of the struct used to
+// represent the objects of that endpoint. An empty report means the struct
+// conforms to the schema served by the IGDB.
+type SchemaReport struct {
+	Endpoint endpoint
+	// Missing contains the fields reported by the IGDB
+	// that the struct does not have a field for.
+	Missing []string
+	// Extra contains the JSON tags of the struct fields
+	// that the IGDB no longer reports.
+	Extra []string
+	// Mistagged contains the JSON tags of the struct fields that only match
+	// a field reported by the IGDB when ignoring case (e.g. "ID" instead of "id").
+	Mistagged []string
+}
+
+// Conforms returns true if the report contains no missing,
+// extra, or mistagged fields.
+func (r *SchemaReport) Conforms() bool {
+	return len(r.Missing) == 0 && len(r.Extra) == 0 && len(r.Mistagged) == 0
+}
+
+// CompareSchema compares the provided list of fields, as returned by a
+// service's Fields function, with the JSON tags of the struct used to
+// represent the objects of the provided endpoint. Expanded subfields (e.g.
+// cover.url) and the asterisk are ignored. If the endpoint has no
+// corresponding struct, an error is returned.
+func CompareSchema(end endpoint, fields []string) (*SchemaReport, error) {
+	t, ok := modelTypes[end]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownEndpoint, "cannot compare schema of '%s' endpoint", end)
+	}
+
+	served := make(map[string]bool, len(fields))
+	folded := make(map[string]string, len(fields))
+	for _, f := range fields {
+		if f == "*" || strings.Contains(f, ".") {
+			continue
+		}
+		served[f] = true
+		folded[strings.ToLower(f)] = f
+	}
+
+	r := &SchemaReport{Endpoint: end}
+	matched := make(map[string]bool)
+
+	for _, tag := range modelTags(t) {
+		switch {
+		case served[tag]:
+			matched[tag] = true
+		case folded[strings.ToLower(tag)] != "":
+			matched[folded[strings.ToLower(tag)]] = true
+			r.Mistagged = append(r.Mistagged, tag)
+		default:
+			r.Extra = append(r.Extra, tag)
+		}
+	}
+
+	for f := range served {
+		if !matched[f] {
+			r.Missing = append(r.Missing, f)
+		}
+	}
+
+	sort.Strings(r.Missing)
+	sort.Strings(r.Extra)
+	sort.Strings(r.Mistagged)
+
+	return r, nil
+}
+
+// CheckSchemas retrieves the up-to-date list of fields for every endpoint
+// supported by this package and compares each list with the struct used
+// to represent the objects of that endpoint. The reports are returned
+// in order of endpoint.
+func (c *Client) CheckSchemas() ([]*SchemaReport, error) {
+	ends := make([]string, 0, len(modelTypes))
+	for end := range modelTypes {
+		ends = append(ends, string(end))
+	}
+	sort.Strings(ends)
+
+	var reports []*SchemaReport
+	for _, end := range ends {
+		f, err := c.getFields(endpoint(end))
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get fields of '%s' endpoint", end)
+		}
+
+		r, err := CompareSchema(endpoint(end), f)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, r)
+	}
+
+	return reports, nil
+}
+
+// modelTags returns the JSON tags of every exported field of the provided
+// struct type, including the fields of embedded structs.
+func modelTags(t reflect.Type) []string {
+	fields := modelFields(t)
+	tags := make([]string, len(fields))
+	for i, f := range fields {
+		tags[i] = f.tag
+	}
+
+	return tags
+}
+
+// modelField is an exported field of a model struct type
+// along with the JSON tag the field is decoded from.
+type modelField struct {
+	tag   string
+	index []int
+	typ   reflect.Type
+}
+
+// modelFields returns every exported field of the provided struct type in
+// order of declaration, including the fields of embedded structs. The index
+// of each field is relative to the provided type.
+func modelFields(t reflect.Type) []modelField {
+	var fields []modelField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		name := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			for _, f := range modelFields(sf.Type) {
+				f.index = append([]int{i}, f.index...)
+				fields = append(fields, f)
+			}
+			continue
+		}
+
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, modelField{tag: name, index: []int{i}, typ: sf.Type})
+	}
+
+	return fields
+}
@@ -0,0 +1,181 @@
+package igdb
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// testSchemaDeprecated lists the struct fields that are kept for
+// compatibility even though the IGDB no longer reports them.
+var testSchemaDeprecated = map[endpoint][]string{
+	EndpointCharacter: {"people"},
+	EndpointPlatform:  {"product_family"},
+	EndpointSearch:    {"person"},
+}
+
+// testSchemaFile returns the name of the recorded meta fixture for the given endpoint.
+func testSchemaFile(end endpoint) string {
+	name := strings.ToLower(modelTypes[end].Name())
+	if end == EndpointSearch {
+		name = "search"
+	}
+
+	return "test_data/" + name + "_meta.json"
+}
+
+func TestCompareSchema(t *testing.T) {
+	var tests = []struct {
+		name       string
+		end        endpoint
+		fields     []string
+		wantReport *SchemaReport
+		wantErr    error
+	}{
+		{
+			"Conforming fields",
+			EndpointGenre,
+			[]string{"id", "created_at", "name", "slug", "updated_at", "url"},
+			&SchemaReport{Endpoint: EndpointGenre},
+			nil,
+		},
+		{
+			"Missing fields",
+			EndpointGenre,
+			[]string{"id", "checksum", "created_at", "name", "slug", "updated_at", "url"},
+			&SchemaReport{Endpoint: EndpointGenre, Missing: []string{"checksum"}},
+			nil,
+		},
+		{
+			"Extra fields",
+			EndpointGenre,
+			[]string{"id", "name", "slug", "url"},
+			&SchemaReport{Endpoint: EndpointGenre, Extra: []string{"created_at", "updated_at"}},
+			nil,
+		},
+		{
+			"Embedded image fields",
+			EndpointCover,
+			[]string{"id", "alpha_channel", "animated", "game", "height", "image_id", "url", "width"},
+			&SchemaReport{Endpoint: EndpointCover},
+			nil,
+		},
+		{
+			"Asterisk and expanded fields",
+			EndpointGenre,
+			[]string{"*", "id", "created_at", "name", "slug", "updated_at", "url", "games.name"},
+			&SchemaReport{Endpoint: EndpointGenre},
+			nil,
+		},
+		{
+			"Unknown endpoint",
+			EndpointTitle,
+			[]string{"id"},
+			nil,
+			ErrUnknownEndpoint,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := CompareSchema(test.end, test.fields)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(r, test.wantReport) {
+				t.Errorf("got: <%v>, \nwant: <%v>", r, test.wantReport)
+			}
+		})
+	}
+}
+
+func TestCompareSchema_Mistagged(t *testing.T) {
+	modelTypes[testEndpoint] = reflect.TypeOf(struct {
+		ID   int    `json:"ID"`
+		Name string `json:"name"`
+	}{})
+	defer delete(modelTypes, testEndpoint)
+
+	r, err := CompareSchema(testEndpoint, []string{"id", "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &SchemaReport{Endpoint: testEndpoint, Mistagged: []string{"ID"}}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("got: <%v>, want: <%v>", r, want)
+	}
+
+	if r.Conforms() {
+		t.Errorf("got: <conforms>, want: <does not conform>")
+	}
+}
+
+func TestCompareSchema_Fixtures(t *testing.T) {
+	for end := range modelTypes {
+		t.Run(string(end), func(t *testing.T) {
+			f, err := ioutil.ReadFile(testSchemaFile(end))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var fields []string
+			if err = json.Unmarshal(f, &fields); err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := CompareSchema(end, fields)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(r.Mistagged) > 0 {
+				t.Errorf("got mistagged fields: <%v>, want: <none>", r.Mistagged)
+			}
+
+			if !equalSlice(r.Extra, testSchemaDeprecated[end]) {
+				t.Errorf("got extra fields: <%v>, want: <%v>", r.Extra, testSchemaDeprecated[end])
+			}
+
+			if len(r.Missing) > 0 {
+				t.Logf("fields not yet supported: %v", r.Missing)
+			}
+		})
+	}
+}
+
+func TestClient_CheckSchemas(t *testing.T) {
+	var tests = []struct {
+		name        string
+		status      int
+		resp        string
+		wantReports int
+		wantErr     error
+	}{
+		{"OK status with regular response", http.StatusOK, `["id", "name"]`, len(modelTypes), nil},
+		{"OK status with empty response", http.StatusOK, "", 0, errInvalidJSON},
+		{"Bad status with empty response", http.StatusBadRequest, "", 0, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			r, err := c.CheckSchemas()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if len(r) != test.wantReports {
+				t.Errorf("got: <%v> reports, want: <%v> reports", len(r), test.wantReports)
+			}
+		})
+	}
+}
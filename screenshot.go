@@ -11,10 +11,19 @@ import (
 // For more information visit: https://api-docs.igdb.com/#screenshot
 type Screenshot struct {
 	Image
+	Presence
+	Extras
 	ID   int `json:"id"`
 	Game int `json:"game"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the Screenshot and
+// records which of its fields were present or unrecognized.
+func (s *Screenshot) UnmarshalJSON(b []byte) error {
+	type screenshot Screenshot
+	return decodeModel(b, (*screenshot)(s), &s.Presence, &s.Extras)
+}
+
 // ScreenshotService handles all the API calls for the IGDB Screenshot endpoint.
 type ScreenshotService service
 
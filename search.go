@@ -1,31 +1,46 @@
 package igdb
 
-import "github.com/pkg/errors"
+import (
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+)
 
 //go:generate gomodifytags -file $GOFILE -struct SearchResult -add-tags json -w
 
 // SearchResult represents a result from searching the IGDB.
 // It can contain: Characters, Collections Games, People, Platforms, and Themes.
 type SearchResult struct {
-	AlternativeName string `json:"alternative_name"`
-	Character       int    `json:"character"`
-	Collection      int    `json:"collection"`
-	Company         int    `json:"company"`
-	Description     string `json:"description"`
-	Game            int    `json:"game"`
-	Name            string `json:"name"`
-	Person          int    `json:"person"`
-	Platform        int    `json:"platform"`
-	PublishedAt     int    `json:"published_at"`
-	TestDummy       int    `json:"test_dummy"`
-	Theme           int    `json:"theme"`
+	Presence
+	Extras
+	AlternativeName string    `json:"alternative_name"`
+	Character       int       `json:"character"`
+	Collection      int       `json:"collection"`
+	Company         int       `json:"company"`
+	Description     string    `json:"description"`
+	Game            int       `json:"game"`
+	Name            string    `json:"name"`
+	Person          int       `json:"person"`
+	Platform        int       `json:"platform"`
+	PublishedAt     Timestamp `json:"published_at"`
+	TestDummy       int       `json:"test_dummy"`
+	Theme           int       `json:"theme"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the SearchResult and
+// records which of its fields were present or unrecognized.
+func (s *SearchResult) UnmarshalJSON(b []byte) error {
+	type searchResult SearchResult
+	return decodeModel(b, (*searchResult)(s), &s.Presence, &s.Extras)
 }
 
 // Search returns a list of SearchResults using the provided query. Provide functional
 // options to sort, filter, and paginate the results. If no results are found, an error
 // is returned.
-// Search can only search through Characters, Collections, Games, People, Platforms,
-// and Themes.
+// Search can only search through Characters, Collections, Companies, Games,
+// Platforms, and Themes. Use the HydrateSearch function to retrieve the
+// objects the results refer to.
 func (c *Client) Search(qry string, opts ...Option) ([]*SearchResult, error) {
 	var res []*SearchResult
 
@@ -37,3 +52,86 @@ func (c *Client) Search(qry string, opts ...Option) ([]*SearchResult, error) {
 
 	return res, nil
 }
+
+//go:generate gomodifytags -file $GOFILE -struct HydratedSearchResult -add-tags json -add-options json=omitempty -w
+
+// HydratedSearchResult contains a SearchResult along with the IGDB object it
+// refers to. Only the field matching the kind of object the result refers to
+// is populated; the rest are left nil. If the object could not be found in
+// the IGDB, every field besides Result is left nil.
+type HydratedSearchResult struct {
+	Result     *SearchResult `json:"result,omitempty"`
+	Character  *Character    `json:"character,omitempty"`
+	Collection *Collection   `json:"collection,omitempty"`
+	Company    *Company      `json:"company,omitempty"`
+	Game       *Game         `json:"game,omitempty"`
+	Platform   *Platform     `json:"platform,omitempty"`
+	Theme      *Theme        `json:"theme,omitempty"`
+}
+
+// Entity returns the IGDB object the HydratedSearchResult refers to, such as
+// a *Game or a *Company, along with the endpoint it was retrieved from. If
+// the object was not found, nil and an empty endpoint are returned.
+func (h *HydratedSearchResult) Entity() (interface{}, endpoint) {
+	v := reflect.ValueOf(h).Elem()
+	for _, tag := range searchTags() {
+		if f := fieldByTag(v, tag); !f.IsNil() {
+			return f.Interface(), references[EndpointSearch][tag]
+		}
+	}
+
+	return nil, ""
+}
+
+// HydrateSearch retrieves the IGDB objects the provided SearchResults refer
+// to. The IDs of each kind of object are collected across every result so
+// that each related endpoint is only requested as many times as the maximum
+// limit of 500 results requires, regardless of the number of results. The
+// provided SearchResults must have been retrieved with the fields of the
+// objects they refer to. The results are returned in the same order as the
+// provided SearchResults, skipping any nil SearchResults.
+func (c *Client) HydrateSearch(results []*SearchResult) ([]*HydratedSearchResult, error) {
+	hyd := make([]*HydratedSearchResult, 0, len(results))
+	for _, r := range results {
+		if r != nil {
+			hyd = append(hyd, &HydratedSearchResult{Result: r})
+		}
+	}
+
+	for _, tag := range searchTags() {
+		end := references[EndpointSearch][tag]
+
+		var ids []int
+		for _, h := range hyd {
+			ids = append(ids, referencedIDs(fieldByTag(reflect.ValueOf(h.Result), tag))...)
+		}
+
+		if len(ids) == 0 {
+			continue
+		}
+
+		found, err := c.getByIDs(end, ids)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot hydrate search results of '%s' endpoint", end)
+		}
+
+		for _, h := range hyd {
+			refs := referencedIDs(fieldByTag(reflect.ValueOf(h.Result), tag))
+			setReferences(fieldByTag(reflect.ValueOf(h), tag), refs, found)
+		}
+	}
+
+	return hyd, nil
+}
+
+// searchTags returns the JSON tags of the SearchResult fields
+// that refer to other IGDB objects in ascending order.
+func searchTags() []string {
+	tags := make([]string, 0, len(references[EndpointSearch]))
+	for tag := range references[EndpointSearch] {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	return tags
+}
@@ -3,8 +3,10 @@ package igdb
 import (
 	"encoding/json"
 	"github.com/pkg/errors"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 )
@@ -56,3 +58,87 @@ func TestClient_Search(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_HydrateSearch(t *testing.T) {
+	var bodies []string
+	routes := map[string]string{
+		"/games/":     `[{"id": 1942, "name": "The Witcher 3: Wild Hunt"}, {"id": 7346, "name": "The Legend of Zelda: Breath of the Wild"}]`,
+		"/companies/": `[{"id": 70, "name": "Nintendo"}]`,
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, r.URL.Path+" "+string(b))
+		resp, ok := routes[r.URL.Path]
+		if !ok {
+			resp = "[]"
+		}
+		io.WriteString(w, resp)
+	}))
+	defer ts.Close()
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	res := []*SearchResult{
+		{Name: "Breath of the Wild", Game: 7346},
+		{Name: "Nintendo", Company: 70},
+		nil,
+		{Name: "The Witcher 3", Game: 1942},
+		{Name: "Missing", Character: 99},
+	}
+
+	hyd, err := c.HydrateSearch(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(hyd) != 4 {
+		t.Fatalf("got: <%v> results, want: <%v> results", len(hyd), 4)
+	}
+
+	if len(bodies) != 3 {
+		t.Errorf("got: <%v>, want: <one request per endpoint>", bodies)
+	}
+
+	if hyd[0].Game == nil || hyd[0].Game.ID != 7346 || hyd[0].Result != res[0] {
+		t.Errorf("got: <%v>, want: <game 7346>", hyd[0].Game)
+	}
+
+	if obj, end := hyd[1].Entity(); end != EndpointCompany || obj.(*Company).Name != "Nintendo" {
+		t.Errorf("got: <%v, %v>, want: <Nintendo, %v>", obj, end, EndpointCompany)
+	}
+
+	if hyd[2].Game == nil || hyd[2].Game.ID != 1942 || hyd[2].Company != nil {
+		t.Errorf("got: <%v>, want: <game 1942 only>", hyd[2])
+	}
+
+	if obj, end := hyd[3].Entity(); obj != nil || end != "" {
+		t.Errorf("got: <%v, %v>, want: <nil, empty endpoint>", obj, end)
+	}
+}
+
+func TestClient_HydrateSearchErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		wantErr error
+	}{
+		{"No results", http.StatusOK, "[]", nil},
+		{"Empty response", http.StatusOK, "", errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			_, err := c.HydrateSearch([]*SearchResult{{Game: 1}})
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package igdb
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+)
+
+// SeriesFilter narrows down the Games returned for a franchise or collection.
+// Games must match one of the Categories, if any are provided, and must have
+// been released on one of the Platforms, if any are provided. For example,
+// a SeriesFilter with only the MainGame category leaves out DLCs, bundles,
+// and other additional content. The Categories are matched against both the
+// deprecated category field and the game_type field of the Games, since the
+// IDs of the IGDB's Game Types share the values of the GameCategory constants.
+type SeriesFilter struct {
+	Categories []GameCategory
+	Platforms  []int
+}
+
+// SeriesGame is a Game of a franchise or collection along with the date it
+// was first released. If the SeriesFilter provided any platforms, Released
+// is the earliest release date on those platforms instead of the first
+// release date of the Game.
+type SeriesGame struct {
+	Game     *Game     `json:"game"`
+	Released Timestamp `json:"released"`
+}
+
+// Games returns every Game in the Franchise identified by the provided IGDB
+// ID in release order, including Games that only list it among their
+// secondary franchises. Provide a SeriesFilter to only return Games of
+// specific categories or platforms; a nil filter returns every Game. Games
+// without a known release date are placed last. If no Games match, an error
+// is returned.
+func (fs *FranchiseService) Games(id int, filter *SeriesFilter) ([]*SeriesGame, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	sg, err := fs.client.seriesGames(filter, setFilterAny(OpContainsAtLeast, []string{strconv.Itoa(id)}, "franchise", "franchises"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games of Franchise with ID %v", id)
+	}
+
+	return sg, nil
+}
+
+// Games returns every Game in the Collection identified by the provided IGDB
+// ID in release order. Provide a SeriesFilter to only return Games of
+// specific categories or platforms; a nil filter returns every Game. Games
+// without a known release date are placed last. If no Games match, an error
+// is returned.
+func (cs *CollectionService) Games(id int, filter *SeriesFilter) ([]*SeriesGame, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	sg, err := cs.client.seriesGames(filter, setFilterAny(OpContainsAtLeast, []string{strconv.Itoa(id)}, "collection", "collections"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games of Collection with ID %v", id)
+	}
+
+	return sg, nil
+}
+
+// seriesGames retrieves every Game matching the provided series option and
+// filter and returns them in release order.
+func (c *Client) seriesGames(filter *SeriesFilter, series Option) ([]*SeriesGame, error) {
+	if filter == nil {
+		filter = &SeriesFilter{}
+	}
+
+	for _, p := range filter.Platforms {
+		if p < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	opts := []Option{SetFields("*"), series}
+	if len(filter.Categories) > 0 {
+		cats := make([]string, len(filter.Categories))
+		for i, cat := range filter.Categories {
+			cats[i] = strconv.Itoa(int(cat))
+		}
+		opts = append(opts, setFilterAny(OpContainsAtLeast, cats, "category", "game_type"))
+	}
+	if len(filter.Platforms) > 0 {
+		opts = append(opts, SetFilter("platforms", OpContainsAtLeast, sliceconv.Itoa(filter.Platforms)...))
+	}
+
+	var games []*Game
+	if err := c.postAll(EndpointGame, &games, opts...); err != nil {
+		return nil, err
+	}
+
+	if len(games) == 0 {
+		return nil, ErrNoResults
+	}
+
+	sg := make([]*SeriesGame, len(games))
+	for i, g := range games {
+		sg[i] = &SeriesGame{Game: g, Released: g.FirstReleaseDate}
+	}
+
+	if len(filter.Platforms) > 0 {
+		released, err := c.platformReleases(games, filter.Platforms)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range sg {
+			s.Released = released[s.Game.ID]
+		}
+	}
+
+	sort.SliceStable(sg, func(i, j int) bool {
+		a, b := sg[i], sg[j]
+		if a.Released.IsZero() != b.Released.IsZero() {
+			return b.Released.IsZero()
+		}
+		if a.Released != b.Released {
+			return a.Released < b.Released
+		}
+		if a.Game.Name != b.Game.Name {
+			return a.Game.Name < b.Game.Name
+		}
+		return a.Game.ID < b.Game.ID
+	})
+
+	return sg, nil
+}
+
+// platformReleases returns the earliest known release date of each provided
+// Game on any of the provided platforms, keyed by Game ID.
+func (c *Client) platformReleases(games []*Game, platforms []int) (map[int]Timestamp, error) {
+	ids := make([]int, len(games))
+	for i, g := range games {
+		ids[i] = g.ID
+	}
+
+	released := make(map[int]Timestamp, len(ids))
+	for start := 0; start < len(ids); start += maxLimit {
+		stop := start + maxLimit
+		if stop > len(ids) {
+			stop = len(ids)
+		}
+
+		var rds []*ReleaseDate
+		err := c.postAll(EndpointReleaseDate, &rds,
+			SetFields("date", "game", "platform"),
+			SetFilter("game", OpContainsAtLeast, sliceconv.Itoa(ids[start:stop])...),
+			SetFilter("platform", OpContainsAtLeast, sliceconv.Itoa(platforms)...),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot get release dates")
+		}
+
+		for _, rd := range rds {
+			if rd.Date.IsZero() {
+				continue
+			}
+			if cur, ok := released[rd.Game]; !ok || rd.Date < cur {
+				released[rd.Game] = rd.Date
+			}
+		}
+	}
+
+	return released, nil
+}
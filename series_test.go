@@ -0,0 +1,155 @@
+package igdb
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const testSeriesGames = `[
+	{"id": 10, "name": "Sequel", "first_release_date": 2000},
+	{"id": 11, "name": "Original", "first_release_date": 1000},
+	{"id": 12, "name": "Announced"},
+	{"id": 13, "name": "Compilation", "first_release_date": 1000}
+]`
+
+// testSeriesIDs returns the IDs of the provided SeriesGames in order.
+func testSeriesIDs(sg []*SeriesGame) []int {
+	var ids []int
+	for _, s := range sg {
+		ids = append(ids, s.Game.ID)
+	}
+
+	return ids
+}
+
+func TestFranchiseService_Games(t *testing.T) {
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		if strings.Contains(body, "offset 0") {
+			io.WriteString(w, testSeriesGames)
+			return
+		}
+		io.WriteString(w, "[]")
+	}))
+	defer ts.Close()
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	sg, err := c.Franchises.Games(7, &SeriesFilter{Categories: []GameCategory{MainGame, Expansion}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := testSeriesIDs(sg); !reflect.DeepEqual(ids, []int{13, 11, 10, 12}) {
+		t.Errorf("got: <%v>, want: <%v>", ids, []int{13, 11, 10, 12})
+	}
+
+	if sg[2].Released != 2000 {
+		t.Errorf("got: <%v>, want: <%v>", sg[2].Released, 2000)
+	}
+
+	for _, want := range []string{"(franchise = (7) | franchises = (7))", "(category = (0,2) | game_type = (0,2))"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("got: <%v>, want: <%v>", body, want)
+		}
+	}
+}
+
+func TestCollectionService_Games(t *testing.T) {
+	routes := map[string]string{
+		"/games/":         testSeriesGames,
+		"/release_dates/": `[{"game": 11, "platform": 6, "date": 900}, {"game": 10, "platform": 6, "date": 800}, {"game": 10, "platform": 48, "date": 500}, {"game": 13, "platform": 6}]`,
+	}
+
+	ts, c := testServerRoutes(routes)
+	defer ts.Close()
+
+	sg, err := c.Collections.Games(3, &SeriesFilter{Platforms: []int{6, 48}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := testSeriesIDs(sg); !reflect.DeepEqual(ids, []int{10, 11, 12, 13}) {
+		t.Errorf("got: <%v>, want: <%v>", ids, []int{10, 11, 12, 13})
+	}
+
+	if sg[0].Released != 500 || !sg[3].Released.IsZero() {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", sg[0].Released, sg[3].Released, 500, 0)
+	}
+}
+
+func TestCollectionService_GamesCollections(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		if strings.Contains(string(b), "(collection = (3) | collections = (3))") && strings.Contains(string(b), "offset 0") {
+			io.WriteString(w, `[{"id": 20, "name": "Linked Through Collections", "collections": [3, 4]}]`)
+			return
+		}
+		io.WriteString(w, "[]")
+	}))
+	defer ts.Close()
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	sg, err := c.Collections.Games(3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := testSeriesIDs(sg); !reflect.DeepEqual(ids, []int{20}) {
+		t.Errorf("got: <%v>, want: <%v>", ids, []int{20})
+	}
+}
+
+func TestSeriesGamesErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		id      int
+		filter  *SeriesFilter
+		wantErr error
+	}{
+		{"Negative ID", http.StatusOK, "[]", -1, nil, ErrNegativeID},
+		{"Negative platform", http.StatusOK, "[]", 1, &SeriesFilter{Platforms: []int{-6}}, ErrNegativeID},
+		{"No results", http.StatusOK, "[]", 1, nil, ErrNoResults},
+		{"Empty response", http.StatusOK, "", 1, nil, errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", 1, nil, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			sg, err := c.Franchises.Games(test.id, test.filter)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if sg != nil {
+				t.Errorf("got: <%v>, want: <nil>", sg)
+			}
+
+			sg, err = c.Collections.Games(test.id, test.filter)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if sg != nil {
+				t.Errorf("got: <%v>, want: <nil>", sg)
+			}
+		})
+	}
+}
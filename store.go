@@ -0,0 +1,279 @@
+package igdb
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+)
+
+// ErrEmptyUIDs occurs when a function expecting external
+// store identifiers is called without any.
+var ErrEmptyUIDs = errors.New("UIDs argument empty")
+
+// storeURLs maps each ExternalGameCategory whose store pages can be linked
+// to by the identifier of a game alone to the template of those links. The
+// identifier replaces the "{uid}" in the template.
+var storeURLs = map[ExternalGameCategory]string{
+	ExternalSteam:              "https://store.steampowered.com/app/{uid}",
+	ExternalYoutube:            "https://www.youtube.com/watch?v={uid}",
+	ExternalMicrosoft:          "https://www.microsoft.com/store/apps/{uid}",
+	ExternalApple:              "https://apps.apple.com/app/id{uid}",
+	ExternalAndroid:            "https://play.google.com/store/apps/details?id={uid}",
+	ExternalAmazonASIN:         "https://www.amazon.com/dp/{uid}",
+	ExternalOculus:             "https://www.oculus.com/experiences/app/{uid}",
+	ExternalXboxMarketplace:    "https://www.xbox.com/games/store/_/{uid}",
+	ExternalPlaystationStoreUS: "https://store.playstation.com/en-us/product/{uid}",
+}
+
+// StoreURL returns the URL of the page identified by the provided UID on the
+// store or service of the ExternalGameCategory. If the store's pages cannot
+// be linked to by their UID alone, such as GOG or Epic Games Store pages,
+// an empty string is returned.
+func (e ExternalGameCategory) StoreURL(uid string) string {
+	tmpl, ok := storeURLs[e]
+	if !ok || uid == "" {
+		return ""
+	}
+
+	return strings.Replace(tmpl, "{uid}", url.PathEscape(uid), 1)
+}
+
+// StoreLink is the identifier of a Game on an external store or service,
+// such as a Steam app ID, along with the URL of its page there.
+type StoreLink struct {
+	Category ExternalGameCategory `json:"category"`
+	UID      string               `json:"uid"`
+	URL      string               `json:"url"`
+}
+
+// ByExternalID returns the Game identified by the provided UID on the store
+// or service of the provided ExternalGameCategory. For example, the Game with
+// the Steam app ID 292030 is found with the ExternalSteam category and the UID
+// "292030". Provide the SetFields functional option if you need to specify
+// which fields of the Game to retrieve. If the UID does not match any Game,
+// an error is returned.
+func (gs *GameService) ByExternalID(cat ExternalGameCategory, uid string, opts ...Option) (*Game, error) {
+	if uid == "" {
+		return nil, ErrEmptyUIDs
+	}
+
+	ids, err := gs.externalGameIDs(cat, []string{uid})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Game with %v UID %s", cat, uid)
+	}
+
+	id, ok := ids[uid]
+	if !ok {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get Game with %v UID %s", cat, uid)
+	}
+
+	g, err := gs.Get(id, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Game with %v UID %s", cat, uid)
+	}
+
+	return g, nil
+}
+
+// ByExternalIDs returns the Games identified by the provided UIDs on the
+// store or service of the provided ExternalGameCategory, keyed by UID. For
+// example, a batch of GOG product IDs is looked up with the ExternalGOG
+// category. The Games are retrieved with as few requests as the maximum
+// limit allows. Any UID that does not match a Game is left out. If none
+// of the UIDs match a Game, an error is returned.
+func (gs *GameService) ByExternalIDs(cat ExternalGameCategory, uids []string) (map[string]*Game, error) {
+	if len(uids) < 1 {
+		return nil, ErrEmptyUIDs
+	}
+
+	ids, err := gs.externalGameIDs(cat, uids)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with %v UIDs %v", cat, uids)
+	}
+
+	gameIDs := make([]int, 0, len(ids))
+	for _, id := range ids {
+		gameIDs = append(gameIDs, id)
+	}
+
+	found, err := gs.client.getByIDs(gs.end, gameIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Games with %v UIDs %v", cat, uids)
+	}
+
+	games := make(map[string]*Game, len(ids))
+	for uid, id := range ids {
+		if obj, ok := found[id]; ok {
+			games[uid] = obj.Interface().(*Game)
+		}
+	}
+
+	if len(games) == 0 {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get Games with %v UIDs %v", cat, uids)
+	}
+
+	return games, nil
+}
+
+// BySteamID returns the Game with the provided Steam app ID. Provide the
+// SetFields functional option if you need to specify which fields of the
+// Game to retrieve. If the app ID does not match any Game, an error is
+// returned.
+func (gs *GameService) BySteamID(appID int, opts ...Option) (*Game, error) {
+	if appID < 0 {
+		return nil, ErrNegativeID
+	}
+
+	return gs.ByExternalID(ExternalSteam, strconv.Itoa(appID), opts...)
+}
+
+// ByGOGIDs returns the Games with the provided GOG product IDs, keyed by
+// product ID. Any ID that does not match a Game is left out. If none of
+// the IDs match a Game, an error is returned.
+func (gs *GameService) ByGOGIDs(ids []int) (map[int]*Game, error) {
+	if len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	found, err := gs.ByExternalIDs(ExternalGOG, sliceconv.Itoa(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	games := make(map[int]*Game, len(found))
+	for uid, g := range found {
+		id, err := strconv.Atoi(uid)
+		if err != nil {
+			continue
+		}
+		games[id] = g
+	}
+
+	return games, nil
+}
+
+// externalGameIDs returns the IDs of the Games identified by the provided
+// UIDs on the store or service of the provided category, keyed by UID. The
+// IDs of the IGDB's External Game Sources share the values of the deprecated
+// categories, so ExternalGames reporting either field are matched.
+func (gs *GameService) externalGameIDs(cat ExternalGameCategory, uids []string) (map[string]int, error) {
+	ids := make(map[string]int, len(uids))
+	for start := 0; start < len(uids); start += maxLimit {
+		stop := start + maxLimit
+		if stop > len(uids) {
+			stop = len(uids)
+		}
+
+		quoted := make([]string, stop-start)
+		for i, uid := range uids[start:stop] {
+			quoted[i] = strconv.Quote(uid)
+		}
+
+		var ext []*ExternalGame
+		err := gs.client.postAll(EndpointExternalGame, &ext,
+			SetFields("game", "uid"),
+			setFilterAny(OpEquals, []string{strconv.Itoa(int(cat))}, "category", "external_game_source"),
+			SetFilter("uid", OpContainsAtLeast, quoted...),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range ext {
+			if _, ok := ids[e.UID]; !ok && e.Game != 0 {
+				ids[e.UID] = e.Game
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// StoreLinks returns the identifiers of the Game identified by the provided
+// IGDB ID on every external store or service the IGDB knows of, along with
+// the URLs of its pages there. The URL reported by the IGDB is used when
+// available; otherwise, the URL is generated from the identifier, if the
+// store allows. ExternalGames without a category use their external game
+// source instead. The StoreLinks are sorted by category and UID. If the Game
+// has no known identifiers, an error is returned.
+func (gs *GameService) StoreLinks(id int) ([]*StoreLink, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	links, err := gs.StoreLinksByGames([]int{id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(links[id]) == 0 {
+		return nil, errors.Wrapf(ErrNoResults, "cannot get store links of Game with ID %v", id)
+	}
+
+	return links[id], nil
+}
+
+// StoreLinksByGames returns the StoreLinks of every Game identified by the
+// provided IGDB IDs, keyed by Game ID, with as few requests as the maximum
+// limit allows. Games without any known identifiers are left out.
+func (gs *GameService) StoreLinksByGames(ids []int) (map[int][]*StoreLink, error) {
+	if len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	ids = uniqueIDs(ids)
+	links := make(map[int][]*StoreLink, len(ids))
+	for start := 0; start < len(ids); start += maxLimit {
+		stop := start + maxLimit
+		if stop > len(ids) {
+			stop = len(ids)
+		}
+
+		var ext []*ExternalGame
+		err := gs.client.postAll(EndpointExternalGame, &ext,
+			SetFields("category", "external_game_source", "game", "uid", "url"),
+			SetFilter("game", OpContainsAtLeast, sliceconv.Itoa(ids[start:stop])...),
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get store links of Games with IDs %v", ids[start:stop])
+		}
+
+		for _, e := range ext {
+			l := &StoreLink{Category: e.Category, UID: e.UID, URL: e.Url}
+			if l.Category == 0 {
+				l.Category = ExternalGameCategory(e.ExternalGameSource)
+			}
+			if l.URL == "" {
+				l.URL = l.Category.StoreURL(e.UID)
+			}
+			links[e.Game] = append(links[e.Game], l)
+		}
+	}
+
+	for _, l := range links {
+		sort.Slice(l, func(i, j int) bool {
+			if l[i].Category != l[j].Category {
+				return l[i].Category < l[j].Category
+			}
+			return l[i].UID < l[j].UID
+		})
+	}
+
+	return links, nil
+}
@@ -0,0 +1,260 @@
+package igdb
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const testStoreExternalGames = `[
+	{"id": 1, "category": 1, "game": 1942, "uid": "292030"},
+	{"id": 2, "category": 5, "game": 1942, "uid": "1207664643", "url": "https://www.gog.com/game/the_witcher_3_wild_hunt"},
+	{"id": 3, "category": 5, "game": 80, "uid": "1207658924"},
+	{"id": 4, "category": 26, "game": 1942, "uid": "witcher3"},
+	{"id": 5, "category": 1, "game": 1942, "uid": "499450"},
+	{"id": 6, "external_game_source": 1, "game": 80, "uid": "20900"}
+]`
+
+// testStoreServer initializes and returns a test server that responds to
+// ExternalGame and Game requests with the provided bodies and records the
+// body of every ExternalGame request, along with a Client configured for it.
+func testStoreServer(ext, games string, bodies *[]string) (*httptest.Server, *Client) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		switch {
+		case strings.Contains(string(b), "offset 500"):
+			io.WriteString(w, "[]")
+		case r.URL.Path == "/external_games/":
+			*bodies = append(*bodies, string(b))
+			io.WriteString(w, ext)
+		default:
+			io.WriteString(w, games)
+		}
+	}))
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	return ts, c
+}
+
+func TestGameService_BySteamID(t *testing.T) {
+	var bodies []string
+	ts, c := testStoreServer(`[{"id": 1, "game": 1942, "uid": "292030"}]`, `[{"id": 1942, "name": "The Witcher 3: Wild Hunt"}]`, &bodies)
+	defer ts.Close()
+
+	g, err := c.Games.BySteamID(292030, SetFields("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g.ID != 1942 {
+		t.Errorf("got: <%v>, want: <%v>", g.ID, 1942)
+	}
+
+	for _, want := range []string{"(category = 1 | external_game_source = 1)", `uid = ("292030")`} {
+		if len(bodies) != 1 || !strings.Contains(bodies[0], want) {
+			t.Errorf("got: <%v>, want: <%v>", bodies, want)
+		}
+	}
+}
+
+func TestGameService_ByGOGIDs(t *testing.T) {
+	var bodies []string
+	ext := `[{"id": 2, "game": 1942, "uid": "1207664643"}, {"id": 3, "game": 80, "uid": "1207658924"}]`
+	ts, c := testStoreServer(ext, `[{"id": 1942}, {"id": 80}]`, &bodies)
+	defer ts.Close()
+
+	games, err := c.Games.ByGOGIDs([]int{1207664643, 1207658924, 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(games) != 2 || games[1207664643].ID != 1942 || games[1207658924].ID != 80 {
+		t.Errorf("got: <%v>, want: <games 1942 and 80>", games)
+	}
+
+	if len(bodies) != 1 || !strings.Contains(bodies[0], "(category = 5 | external_game_source = 5)") {
+		t.Errorf("got: <%v>, want: <category or source of 5>", bodies)
+	}
+}
+
+func TestGameService_ByExternalIDErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		uids    []string
+		wantErr error
+	}{
+		{"Empty UIDs", http.StatusOK, "[]", nil, ErrEmptyUIDs},
+		{"No results", http.StatusOK, "[]", []string{"730"}, ErrNoResults},
+		{"Empty response", http.StatusOK, "", []string{"730"}, errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", []string{"730"}, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			uid := ""
+			if len(test.uids) > 0 {
+				uid = test.uids[0]
+			}
+
+			g, err := c.Games.ByExternalID(ExternalSteam, uid)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if g != nil {
+				t.Errorf("got: <%v>, want: <nil>", g)
+			}
+
+			games, err := c.Games.ByExternalIDs(ExternalSteam, test.uids)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if games != nil {
+				t.Errorf("got: <%v>, want: <nil>", games)
+			}
+		})
+	}
+
+	c := NewClient(testClientID, testToken, nil)
+	if _, err := c.Games.BySteamID(-1); err != ErrNegativeID {
+		t.Errorf("got: <%v>, want: <%v>", err, ErrNegativeID)
+	}
+
+	if _, err := c.Games.ByGOGIDs([]int{-1}); err != ErrNegativeID {
+		t.Errorf("got: <%v>, want: <%v>", err, ErrNegativeID)
+	}
+}
+
+func TestGameService_StoreLinks(t *testing.T) {
+	var bodies []string
+	ts, c := testStoreServer(testStoreExternalGames, "[]", &bodies)
+	defer ts.Close()
+
+	links, err := c.Games.StoreLinks(1942)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []StoreLink{
+		{Category: ExternalSteam, UID: "292030", URL: "https://store.steampowered.com/app/292030"},
+		{Category: ExternalSteam, UID: "499450", URL: "https://store.steampowered.com/app/499450"},
+		{Category: ExternalGOG, UID: "1207664643", URL: "https://www.gog.com/game/the_witcher_3_wild_hunt"},
+		{Category: ExternalEpicGameStore, UID: "witcher3", URL: ""},
+	}
+
+	if len(links) != len(want) {
+		t.Fatalf("got: <%v> links, want: <%v> links", len(links), len(want))
+	}
+
+	for i := range want {
+		if *links[i] != want[i] {
+			t.Errorf("got: <%v>, want: <%v>", *links[i], want[i])
+		}
+	}
+
+	if len(bodies) != 1 || !strings.Contains(bodies[0], "game = (1942)") {
+		t.Errorf("got: <%v>, want: <game = (1942)>", bodies)
+	}
+
+	all, err := c.Games.StoreLinksByGames([]int{1942, 80, 1942})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(all[1942]) != 4 || len(all[80]) != 2 {
+		t.Fatalf("got: <%v>, want: <4 links for 1942 and 2 links for 80>", all)
+	}
+
+	src := StoreLink{Category: ExternalSteam, UID: "20900", URL: "https://store.steampowered.com/app/20900"}
+	if *all[80][0] != src || all[80][1].URL != "" {
+		t.Errorf("got: <%v, %v>, want: <%v and GOG link without URL>", *all[80][0], *all[80][1], src)
+	}
+}
+
+func TestGameService_StoreLinksErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		id      int
+		wantErr error
+	}{
+		{"Negative ID", http.StatusOK, "[]", -1, ErrNegativeID},
+		{"No results", http.StatusOK, "[]", 1, ErrNoResults},
+		{"Empty response", http.StatusOK, "", 1, errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", 1, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			links, err := c.Games.StoreLinks(test.id)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if links != nil {
+				t.Errorf("got: <%v>, want: <nil>", links)
+			}
+		})
+	}
+}
+
+func TestExternalGameCategory_StoreURL(t *testing.T) {
+	var tests = []struct {
+		cat     ExternalGameCategory
+		uid     string
+		wantURL string
+	}{
+		{ExternalSteam, "292030", "https://store.steampowered.com/app/292030"},
+		{ExternalApple, "1234", "https://apps.apple.com/app/id1234"},
+		{ExternalAndroid, "com.example.game", "https://play.google.com/store/apps/details?id=com.example.game"},
+		{ExternalPlaystationStoreUS, "UP4497-CUSA00527_00-0000000000000001", "https://store.playstation.com/en-us/product/UP4497-CUSA00527_00-0000000000000001"},
+		{ExternalGOG, "1207664643", ""},
+		{ExternalSteam, "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cat.String(), func(t *testing.T) {
+			if u := test.cat.StoreURL(test.uid); u != test.wantURL {
+				t.Errorf("got: <%v>, want: <%v>", u, test.wantURL)
+			}
+		})
+	}
+}
+
+func TestExternalGameCategory_String(t *testing.T) {
+	var tests = []struct {
+		cat      ExternalGameCategory
+		wantName string
+	}{
+		{ExternalSteam, "ExternalSteam"},
+		{ExternalAndroid, "ExternalAndroid"},
+		{ExternalAmazonADG, "ExternalAmazonADG"},
+		{ExternalKartridge, "ExternalKartridge"},
+		{ExternalFocusEntertainment, "ExternalFocusEntertainment"},
+		{ExternalGamejolt, "ExternalGamejolt"},
+		{ExternalGameCategory(21), "ExternalGameCategory(21)"},
+	}
+
+	for _, test := range tests {
+		if name := test.cat.String(); name != test.wantName {
+			t.Errorf("got: <%v>, want: <%v>", name, test.wantName)
+		}
+	}
+}
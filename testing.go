@@ -60,6 +60,41 @@ func testServerString(status int, resp string, headers ...testHeader) (*httptest
 	return startTestServer(status, strings.NewReader(resp), headers...)
 }
 
+// testServerRepeat initializes and returns a test server that will respond to every
+// request with the provided status and response, unlike testServerString which only
+// responds with the response once. testServerRepeat also returns a Client configured
+// specifically for the initialized test server.
+func testServerRepeat(status int, resp string) (*httptest.Server, *Client) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		io.WriteString(w, resp)
+	}))
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	return ts, c
+}
+
+// testServerRoutes initializes and returns a test server that will respond to each
+// request with an OK status and the response mapped to the request's URL path (e.g.
+// "/games/"). Requests to unmapped paths receive an empty array. testServerRoutes also
+// returns a Client configured specifically for the initialized test server.
+func testServerRoutes(routes map[string]string) (*httptest.Server, *Client) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := routes[r.URL.Path]
+		if !ok {
+			resp = "[]"
+		}
+		io.WriteString(w, resp)
+	}))
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	return ts, c
+}
+
 // testServerFile initializes and returns a test server that will respond with the provided status,
 // response read from the given filename, and optional headers. testServerFile also returns a Client
 // configured specifically for the initialized test server.
@@ -11,12 +11,21 @@ import (
 // Theme represents a particular video game theme.
 // For more information visit: https://api-docs.igdb.com/#theme
 type Theme struct {
-	ID        int    `json:"id"`
-	CreatedAt int    `json:"created_at"`
-	Name      string `json:"name"`
-	Slug      string `json:"slug"`
-	UpdatedAt int    `json:"updated_at"`
-	URL       string `json:"url"`
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	CreatedAt Timestamp `json:"created_at"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	UpdatedAt Timestamp `json:"updated_at"`
+	URL       string    `json:"url"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the Theme and
+// records which of its fields were present or unrecognized.
+func (t *Theme) UnmarshalJSON(b []byte) error {
+	type theme Theme
+	return decodeModel(b, (*theme)(t), &t.Presence, &t.Extras)
 }
 
 // ThemeService handles all the API calls for the IGDB Theme endpoint.
@@ -41,6 +50,25 @@ func (ts *ThemeService) Get(id int, opts ...Option) (*Theme, error) {
 	return th[0], nil
 }
 
+// GetBySlug returns a single Theme identified by the provided slug. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the slug does not match any Themes, an error is returned.
+func (ts *ThemeService) GetBySlug(slug string, opts ...Option) (*Theme, error) {
+	if slug == "" {
+		return nil, ErrEmptySlug
+	}
+
+	var th []*Theme
+
+	opts = append(opts, setSlug(slug))
+	err := ts.client.post(ts.end, &th, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Theme with slug %s", slug)
+	}
+
+	return th[0], nil
+}
+
 // List returns a list of Themes identified by the provided list of IGDB IDs.
 // Provide functional options to sort, filter, and paginate the results.
 // Any ID that does not match a Theme is ignored. If none of the IDs
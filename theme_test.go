@@ -61,6 +61,52 @@ func TestThemeService_Get(t *testing.T) {
 	}
 }
 
+func TestThemeService_GetBySlug(t *testing.T) {
+	f, err := ioutil.ReadFile(testThemeGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init := make([]*Theme, 1)
+	err = json.Unmarshal(f, &init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name      string
+		file      string
+		slug      string
+		opts      []Option
+		wantTheme *Theme
+		wantErr   error
+	}{
+		{"Valid response", testThemeGet, "open-world", []Option{SetFields("name")}, init[0], nil},
+		{"Empty slug", testFileEmpty, "", nil, nil, ErrEmptySlug},
+		{"Empty response", testFileEmpty, "open-world", nil, nil, errInvalidJSON},
+		{"Invalid option", testFileEmpty, "open-world", []Option{SetOffset(-99999)}, nil, ErrOutOfRange},
+		{"No results", testFileEmptyArray, "non-existent-slug", nil, nil, ErrNoResults},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c, err := testServerFile(http.StatusOK, test.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ts.Close()
+
+			th, err := c.Themes.GetBySlug(test.slug, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if !reflect.DeepEqual(th, test.wantTheme) {
+				t.Errorf("got: <%v>, \nwant: <%v>", th, test.wantTheme)
+			}
+		})
+	}
+}
+
 func TestThemeService_List(t *testing.T) {
 	f, err := ioutil.ReadFile(testThemeList)
 	if err != nil {
@@ -0,0 +1,116 @@
+package igdb
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// Errors returned by an Option when setting time based options for an API call.
+var (
+	// ErrZeroTime occurs when a zero time.Time value is used as a filter value.
+	ErrZeroTime = errors.New("provided option time value is zero")
+	// ErrInvalidTimeRange occurs when the end of a time range precedes its start.
+	ErrInvalidTimeRange = errors.New("provided option time range ends before it starts")
+)
+
+// timeNow returns the current time. Replaced in tests to produce
+// deterministic relative time filters.
+var timeNow = time.Now
+
+// unixString returns the provided time as a string of Unix seconds,
+// the format the IGDB uses for every timestamp field.
+func unixString(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+// SetTimeFilter is a functional option used to filter the results from an API
+// call using a timestamp field (e.g. created_at or first_release_date) and the
+// provided time. The time is converted into Unix seconds before being compared
+// with the provided operator. Only the numeric operators (e.g. OpGreaterThan or
+// OpLessThanEqual) are meaningful for timestamp fields.
+//
+// Like SetFilter, SetTimeFilter can be set multiple times in a single API call.
+//
+// For more information, visit: https://api-docs.igdb.com/#filters
+func SetTimeFilter(field string, op operator, t time.Time) Option {
+	return func() (apicalypse.Option, error) {
+		if t.IsZero() {
+			return nil, ErrZeroTime
+		}
+
+		return SetFilter(field, op, unixString(t))()
+	}
+}
+
+// SetTimeRange is a functional option used to filter the results from an API
+// call to those with a timestamp field between the provided start and end
+// times, inclusive. If end is before start, an error is returned.
+//
+// For more information, visit: https://api-docs.igdb.com/#filters
+func SetTimeRange(field string, start, end time.Time) Option {
+	return func() (apicalypse.Option, error) {
+		if blank.Is(field) {
+			return nil, ErrEmptyFields
+		}
+		if start.IsZero() || end.IsZero() {
+			return nil, ErrZeroTime
+		}
+		if end.Before(start) {
+			return nil, ErrInvalidTimeRange
+		}
+
+		return apicalypse.Where(
+			field+" >= "+unixString(start),
+			field+" <= "+unixString(end),
+		), nil
+	}
+}
+
+// SetTimeSince is a functional option used to filter the results from an API
+// call to those with a timestamp field within the provided duration before
+// the time of the call. The duration must be positive.
+//
+// For more information, visit: https://api-docs.igdb.com/#filters
+func SetTimeSince(field string, d time.Duration) Option {
+	return func() (apicalypse.Option, error) {
+		if d <= 0 {
+			return nil, ErrOutOfRange
+		}
+
+		return SetTimeFilter(field, OpGreaterThanEqual, timeNow().Add(-d))()
+	}
+}
+
+// SetReleasedBetween is a functional option used to filter Games to those
+// first released between the provided start and end times, inclusive.
+func SetReleasedBetween(start, end time.Time) Option {
+	return SetTimeRange("first_release_date", start, end)
+}
+
+// SetCreatedSince is a functional option used to filter the results from an
+// API call to those added to the IGDB within the provided duration.
+func SetCreatedSince(d time.Duration) Option {
+	return SetTimeSince("created_at", d)
+}
+
+// SetUpdatedSince is a functional option used to filter the results from an
+// API call to those updated in the IGDB within the provided duration.
+func SetUpdatedSince(d time.Duration) Option {
+	return SetTimeSince("updated_at", d)
+}
+
+// SetOrderNewest is a functional option used to sort the results from an API
+// call by the provided timestamp field with the most recent times first.
+func SetOrderNewest(field string) Option {
+	return SetOrder(field, OrderDescending)
+}
+
+// SetOrderOldest is a functional option used to sort the results from an API
+// call by the provided timestamp field with the least recent times first.
+func SetOrderOldest(field string) Option {
+	return SetOrder(field, OrderAscending)
+}
@@ -0,0 +1,169 @@
+package igdb
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Henry-Sarabia/apicalypse"
+	"github.com/pkg/errors"
+)
+
+var (
+	testTimeStart = time.Date(2015, time.May, 19, 0, 0, 0, 0, time.UTC)
+	testTimeEnd   = time.Date(2015, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+func TestSetTimeFilter(t *testing.T) {
+	var tests = []struct {
+		name       string
+		field      string
+		op         operator
+		time       time.Time
+		wantFilter string
+		wantErr    error
+	}{
+		{"Non-empty field and non-zero time", "created_at", OpGreaterThan, testTimeStart, "where created_at > 1431993600", nil},
+		{"Non-empty field and zero time", "created_at", OpGreaterThan, time.Time{}, "", ErrZeroTime},
+		{"Empty field and non-zero time", "", OpLessThan, testTimeStart, "", ErrEmptyFields},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := SetTimeFilter(test.field, test.op, test.time)()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantFilter) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantFilter)
+			}
+		})
+	}
+}
+
+func TestSetTimeRange(t *testing.T) {
+	var tests = []struct {
+		name        string
+		field       string
+		start       time.Time
+		end         time.Time
+		wantFilters []string
+		wantErr     error
+	}{
+		{"Valid range", "first_release_date", testTimeStart, testTimeEnd, []string{"first_release_date >= 1431993600", "first_release_date <= 1451520000"}, nil},
+		{"Single instant range", "first_release_date", testTimeStart, testTimeStart, []string{"first_release_date >= 1431993600", "first_release_date <= 1431993600"}, nil},
+		{"Reversed range", "first_release_date", testTimeEnd, testTimeStart, nil, ErrInvalidTimeRange},
+		{"Zero start", "first_release_date", time.Time{}, testTimeEnd, nil, ErrZeroTime},
+		{"Zero end", "first_release_date", testTimeStart, time.Time{}, nil, ErrZeroTime},
+		{"Empty field", " ", testTimeStart, testTimeEnd, nil, ErrEmptyFields},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := SetTimeRange(test.field, test.start, test.end)()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, f := range test.wantFilters {
+				if !strings.Contains(q, f) {
+					t.Errorf("got: <%v>, want: <%v>", q, f)
+				}
+			}
+		})
+	}
+}
+
+func TestSetTimeSince(t *testing.T) {
+	timeNow = func() time.Time { return testTimeEnd }
+	defer func() { timeNow = time.Now }()
+
+	var tests = []struct {
+		name       string
+		opt        Option
+		wantFilter string
+		wantErr    error
+	}{
+		{"Positive duration", SetTimeSince("updated_at", 24*time.Hour), "where updated_at >= 1451433600", nil},
+		{"Zero duration", SetTimeSince("updated_at", 0), "", ErrOutOfRange},
+		{"Negative duration", SetTimeSince("updated_at", -time.Hour), "", ErrOutOfRange},
+		{"Updated since", SetUpdatedSince(time.Hour), "where updated_at >= 1451516400", nil},
+		{"Created since", SetCreatedSince(time.Hour), "where created_at >= 1451516400", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := test.opt()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantFilter) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantFilter)
+			}
+		})
+	}
+}
+
+func TestSetOrderNewest(t *testing.T) {
+	var tests = []struct {
+		name    string
+		opt     Option
+		wantOrd string
+		wantErr error
+	}{
+		{"Newest first", SetOrderNewest("first_release_date"), "sort first_release_date desc", nil},
+		{"Oldest first", SetOrderOldest("first_release_date"), "sort first_release_date asc", nil},
+		{"Empty field", SetOrderNewest(""), "", ErrEmptyFields},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := test.opt()
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if test.wantErr != nil {
+				return
+			}
+
+			q, err := apicalypse.Query(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(q, test.wantOrd) {
+				t.Errorf("got: <%v>, want: <%v>", q, test.wantOrd)
+			}
+		})
+	}
+}
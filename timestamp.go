@@ -0,0 +1,44 @@
+package igdb
+
+import (
+	"strconv"
+	"time"
+)
+
+// Timestamp is a point in time represented by the number of seconds elapsed
+// since January 1, 1970 UTC. The IGDB uses this format for every date and time
+// field (e.g. created_at, updated_at, or first_release_date). A Timestamp of
+// zero is considered unset. Timestamp decodes from and encodes to the same
+// JSON numbers as a plain int.
+type Timestamp int
+
+// TimestampOf returns the provided time as a Timestamp. The zero time.Time
+// returns an unset Timestamp.
+func TimestampOf(t time.Time) Timestamp {
+	if t.IsZero() {
+		return 0
+	}
+
+	return Timestamp(t.Unix())
+}
+
+// Time returns the Timestamp as a time.Time in UTC. An unset Timestamp
+// returns the zero time.Time so that it can be checked with IsZero.
+func (ts Timestamp) Time() time.Time {
+	if ts.IsZero() {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(ts), 0).UTC()
+}
+
+// IsZero returns true if the Timestamp is unset.
+func (ts Timestamp) IsZero() bool {
+	return ts == 0
+}
+
+// String returns the Timestamp as a string of Unix seconds, the format
+// expected by the SetFilter functional option.
+func (ts Timestamp) String() string {
+	return strconv.Itoa(int(ts))
+}
@@ -0,0 +1,90 @@
+package igdb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestampOf(t *testing.T) {
+	var tests = []struct {
+		name string
+		time time.Time
+		want Timestamp
+	}{
+		{"Zero time", time.Time{}, 0},
+		{"UTC time", testTimeStart, 1431993600},
+		{"Non-UTC time", testTimeStart.In(time.FixedZone("UTC-8", -8*60*60)), 1431993600},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := TimestampOf(test.time)
+			if ts != test.want {
+				t.Errorf("got: <%v>, want: <%v>", ts, test.want)
+			}
+		})
+	}
+}
+
+func TestTimestamp_Time(t *testing.T) {
+	var tests = []struct {
+		name     string
+		ts       Timestamp
+		wantTime time.Time
+		wantZero bool
+	}{
+		{"Unset timestamp", 0, time.Time{}, true},
+		{"Set timestamp", 1431993600, testTimeStart, false},
+		{"Timestamp before epoch", -86400, time.Date(1969, time.December, 31, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.ts.Time(); !got.Equal(test.wantTime) {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantTime)
+			}
+
+			if got := test.ts.IsZero(); got != test.wantZero {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantZero)
+			}
+
+			if got := test.ts.Time().IsZero(); got != test.wantZero {
+				t.Errorf("got: <%v>, want: <%v>", got, test.wantZero)
+			}
+		})
+	}
+}
+
+func TestTimestamp_String(t *testing.T) {
+	if got := Timestamp(1431993600).String(); got != "1431993600" {
+		t.Errorf("got: <%v>, want: <%v>", got, "1431993600")
+	}
+}
+
+func TestTimestamp_JSON(t *testing.T) {
+	var g Game
+	err := json.Unmarshal([]byte(`{"first_release_date": 1431993600, "created_at": 0}`), &g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !g.FirstReleaseDate.Time().Equal(testTimeStart) {
+		t.Errorf("got: <%v>, want: <%v>", g.FirstReleaseDate.Time(), testTimeStart)
+	}
+
+	if !g.CreatedAt.IsZero() || !g.UpdatedAt.IsZero() {
+		t.Errorf("got: <%v, %v>, want: <unset, unset>", g.CreatedAt, g.UpdatedAt)
+	}
+
+	b, err := json.Marshal(struct {
+		Date Timestamp `json:"date"`
+	}{1431993600})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `{"date":1431993600}` {
+		t.Errorf("got: <%s>, want: <%s>", b, `{"date":1431993600}`)
+	}
+}
@@ -0,0 +1,364 @@
+package igdb
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Henry-Sarabia/blank"
+	"github.com/pkg/errors"
+)
+
+// endpointWebhook is the IGDB API endpoint used to manage
+// the webhooks registered with the user's IGDB API key.
+const endpointWebhook endpoint = "webhooks/"
+
+// maxWebhookBody is the largest request body in bytes
+// that a WebhookHandler will decode.
+const maxWebhookBody = 1 << 20
+
+var (
+	// ErrUnknownWebhookMethod occurs when a webhook is registered
+	// or handled for a method other than create, delete, or update.
+	ErrUnknownWebhookMethod = errors.New("unknown webhook method")
+	// ErrInvalidCallback occurs when a webhook callback is not a function
+	// that takes a pointer to the struct type of the registered endpoint.
+	ErrInvalidCallback = errors.New("webhook callback must be a func taking a pointer to the endpoint's struct type")
+	// ErrEmptySecret occurs when a webhook is registered or handled
+	// without a secret to verify the requests sent by the IGDB.
+	ErrEmptySecret = errors.New("webhook secret empty")
+)
+
+// WebhookMethod specifies which changes to the objects
+// of an IGDB endpoint trigger a webhook.
+type WebhookMethod string
+
+// Expected WebhookMethods from the IGDB.
+const (
+	WebhookCreate WebhookMethod = "create"
+	WebhookDelete WebhookMethod = "delete"
+	WebhookUpdate WebhookMethod = "update"
+)
+
+// webhookSubCategories maps the sub_category values of
+// a Webhook to the WebhookMethod they represent.
+var webhookSubCategories = []WebhookMethod{WebhookCreate, WebhookDelete, WebhookUpdate}
+
+// valid returns true if the WebhookMethod is an expected WebhookMethod.
+func (m WebhookMethod) valid() bool {
+	for _, w := range webhookSubCategories {
+		if m == w {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Webhook represents a webhook registered with the IGDB. Once registered,
+// the IGDB sends every object of the webhook's endpoint that is created,
+// deleted, or updated, depending on its method, to the webhook's URL.
+// For more information visit: https://api-docs.igdb.com/#webhooks
+type Webhook struct {
+	ID              int       `json:"id"`
+	URL             string    `json:"url"`
+	Category        int       `json:"category"`
+	SubCategory     int       `json:"sub_category"`
+	Active          bool      `json:"active"`
+	NumberOfRetries int       `json:"number_of_retries"`
+	APIKey          string    `json:"api_key"`
+	Secret          string    `json:"secret"`
+	CreatedAt       Timestamp `json:"created_at"`
+	UpdatedAt       Timestamp `json:"updated_at"`
+}
+
+// Method returns the WebhookMethod the Webhook was registered for. If the
+// Webhook has an unexpected sub category, an empty method is returned.
+func (w *Webhook) Method() WebhookMethod {
+	if w.SubCategory < 0 || w.SubCategory >= len(webhookSubCategories) {
+		return ""
+	}
+
+	return webhookSubCategories[w.SubCategory]
+}
+
+// WebhookService handles all the API calls for the webhooks
+// registered with the user's IGDB API key.
+type WebhookService service
+
+// Register registers a new webhook that sends the objects of the provided
+// endpoint to the provided URL whenever one is changed by the provided method.
+// The IGDB includes the provided secret in the X-Secret header of every
+// request it sends, which a WebhookHandler uses to verify the request. If
+// the secret is empty, an error is returned.
+func (ws *WebhookService) Register(end endpoint, webhookURL string, secret string, method WebhookMethod) (*Webhook, error) {
+	if blank.Is(secret) {
+		return nil, ErrEmptySecret
+	}
+
+	if _, ok := modelTypes[end]; !ok {
+		return nil, errors.Wrapf(ErrUnknownEndpoint, "cannot register webhook for '%s' endpoint", end)
+	}
+
+	if !method.valid() {
+		return nil, errors.Wrapf(ErrUnknownWebhookMethod, "cannot register webhook for method '%s'", method)
+	}
+
+	form := url.Values{}
+	form.Set("url", webhookURL)
+	form.Set("secret", secret)
+	form.Set("method", string(method))
+
+	var hooks []*Webhook
+	err := ws.do("POST", string(end)+string(ws.end), strings.NewReader(form.Encode()), &hooks)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot register webhook for '%s' endpoint", end)
+	}
+
+	return hooks[0], nil
+}
+
+// Get returns a single Webhook identified by the provided ID.
+// If the ID does not match any Webhooks, an error is returned.
+func (ws *WebhookService) Get(id int) (*Webhook, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var hooks []*Webhook
+	err := ws.do("GET", string(ws.end)+strconv.Itoa(id), nil, &hooks)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get Webhook with ID %v", id)
+	}
+
+	return hooks[0], nil
+}
+
+// List returns every Webhook registered with the user's IGDB API key.
+// If no Webhooks are registered, an error is returned.
+func (ws *WebhookService) List() ([]*Webhook, error) {
+	var hooks []*Webhook
+	err := ws.do("GET", string(ws.end), nil, &hooks)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get list of Webhooks")
+	}
+
+	return hooks, nil
+}
+
+// Test asks the IGDB to send the object identified by the provided entity ID
+// to the Webhook identified by the provided webhook ID. The provided endpoint
+// must be the endpoint the Webhook was registered for.
+func (ws *WebhookService) Test(end endpoint, webhookID, entityID int) error {
+	if webhookID < 0 || entityID < 0 {
+		return ErrNegativeID
+	}
+
+	if _, ok := modelTypes[end]; !ok {
+		return errors.Wrapf(ErrUnknownEndpoint, "cannot test webhook for '%s' endpoint", end)
+	}
+
+	path := string(end) + string(ws.end) + "test/" + strconv.Itoa(webhookID) + "?entityId=" + strconv.Itoa(entityID)
+	if err := ws.do("POST", path, nil, nil); err != nil {
+		return errors.Wrapf(err, "cannot test Webhook with ID %v", webhookID)
+	}
+
+	return nil
+}
+
+// Delete deletes the Webhook identified by the provided ID and returns it.
+// If the ID does not match any Webhooks, an error is returned.
+func (ws *WebhookService) Delete(id int) (*Webhook, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var hooks []*Webhook
+	err := ws.do("DELETE", string(ws.end)+strconv.Itoa(id), nil, &hooks)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot delete Webhook with ID %v", id)
+	}
+
+	return hooks[0], nil
+}
+
+// do sends a request with the provided method and form body to the provided
+// path and stores the Webhooks it responds with in the value pointed to by
+// result. A single Webhook object in the response is stored as a one-element
+// slice. If result is nil, the response body is ignored.
+func (ws *WebhookService) do(method, path string, body io.Reader, result *[]*Webhook) error {
+	req, err := http.NewRequest(method, ws.client.rootURL+path, body)
+	if err != nil {
+		return errors.Wrapf(err, "cannot make %s request for '%s'", method, path)
+	}
+
+	ws.client.setHeaders(req)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	if result == nil {
+		resp, err := ws.client.http.Do(req)
+		if err != nil {
+			return errors.Wrap(err, "http client cannot send request")
+		}
+		defer resp.Body.Close()
+
+		return checkResponse(resp)
+	}
+
+	var raw json.RawMessage
+	if err = ws.client.send(req, &raw); err != nil {
+		return err
+	}
+
+	if len(raw) > 0 && raw[0] == '{' {
+		raw = append(append(json.RawMessage{'['}, raw...), ']')
+	}
+
+	if err = json.Unmarshal(raw, result); err != nil {
+		return errors.Wrap(errInvalidJSON, err.Error())
+	}
+
+	if len(*result) == 0 {
+		return ErrNoResults
+	}
+
+	return nil
+}
+
+// webhookRoute identifies the endpoint and method of the
+// requests a WebhookHandler dispatches to a callback.
+type webhookRoute struct {
+	end    endpoint
+	method WebhookMethod
+}
+
+// WebhookHandler is an http.Handler that receives the objects sent by IGDB
+// webhooks and dispatches them to typed callbacks. The handler expects the
+// path of every request to end with the endpoint and method of the webhook
+// that sent it, such as "/igdb/games/update", so register each webhook with
+// a URL of that form, as built by the WebhookURL function.
+//
+// Requests whose X-Secret header does not match the secret of the handler
+// are rejected with 401 Unauthorized, requests for endpoints and methods
+// without a callback with 404 Not Found, and requests whose body cannot be
+// decoded into the struct type of their endpoint or that are larger than
+// 1 MiB with 400 Bad Request.
+type WebhookHandler struct {
+	secret string
+
+	mu        sync.RWMutex
+	callbacks map[webhookRoute]reflect.Value
+}
+
+// NewWebhookHandler returns a new WebhookHandler that only accepts requests
+// carrying the provided secret in their X-Secret header. If the secret is
+// empty, an error is returned, since requests without the header would
+// otherwise be accepted.
+func NewWebhookHandler(secret string) (*WebhookHandler, error) {
+	if blank.Is(secret) {
+		return nil, ErrEmptySecret
+	}
+
+	return &WebhookHandler{
+		secret:    secret,
+		callbacks: make(map[webhookRoute]reflect.Value),
+	}, nil
+}
+
+// Handle registers the provided callback for the objects of the provided
+// endpoint sent by a webhook of the provided method. The callback must be
+// a function that takes a pointer to the struct type of the endpoint, such
+// as func(*Game) for EndpointGame or func(*Company) for EndpointCompany.
+// Registering a second callback for the same endpoint and method replaces
+// the first. Objects sent by delete webhooks only have their ID populated.
+func (h *WebhookHandler) Handle(end endpoint, method WebhookMethod, callback interface{}) error {
+	t, ok := modelTypes[end]
+	if !ok {
+		return errors.Wrapf(ErrUnknownEndpoint, "cannot handle webhook for '%s' endpoint", end)
+	}
+
+	if !method.valid() {
+		return errors.Wrapf(ErrUnknownWebhookMethod, "cannot handle webhook for method '%s'", method)
+	}
+
+	fn := reflect.ValueOf(callback)
+	if fn.Kind() != reflect.Func || fn.IsNil() || fn.Type().NumIn() != 1 || fn.Type().In(0) != reflect.PtrTo(t) {
+		return errors.Wrapf(ErrInvalidCallback, "cannot handle webhook for '%s' endpoint with %T", end, callback)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.callbacks[webhookRoute{end: end, method: method}] = fn
+
+	return nil
+}
+
+// ServeHTTP verifies the provided webhook request, decodes the object it
+// carries, and calls the callback registered for its endpoint and method.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Secret")), []byte(h.secret)) != 1 {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	route, ok := parseWebhookPath(r.URL.Path)
+	h.mu.RLock()
+	fn, found := h.callbacks[route]
+	h.mu.RUnlock()
+	if !ok || !found {
+		http.NotFound(w, r)
+		return
+	}
+
+	obj := reflect.New(modelTypes[route.end])
+	body := http.MaxBytesReader(w, r.Body, maxWebhookBody)
+	if err := json.NewDecoder(body).Decode(obj.Interface()); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	fn.Call([]reflect.Value{obj})
+	w.WriteHeader(http.StatusOK)
+}
+
+// WebhookURL returns the URL to register a webhook of the provided endpoint
+// and method with so that a WebhookHandler served at the provided base URL
+// can dispatch its requests. For example, a base of "https://example.com/igdb"
+// with EndpointGame and WebhookUpdate returns
+// "https://example.com/igdb/games/update".
+func WebhookURL(base string, end endpoint, method WebhookMethod) string {
+	return strings.TrimSuffix(base, "/") + "/" + string(end) + string(method)
+}
+
+// parseWebhookPath returns the endpoint and method identified by the last
+// two segments of the provided request path.
+func parseWebhookPath(path string) (webhookRoute, bool) {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segs) < 2 {
+		return webhookRoute{}, false
+	}
+
+	route := webhookRoute{
+		end:    endpoint(segs[len(segs)-2] + "/"),
+		method: WebhookMethod(segs[len(segs)-1]),
+	}
+
+	if _, ok := modelTypes[route.end]; !ok || !route.method.valid() {
+		return webhookRoute{}, false
+	}
+
+	return route, true
+}
@@ -0,0 +1,326 @@
+package igdb
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+const testWebhook = `{"id": 42, "url": "https://example.com/igdb/games/update", "category": 1, "sub_category": 2, "active": true, "secret": "shh"}`
+
+// testWebhookServer initializes and returns a test server that records the
+// last request it receives and responds with the provided status and body,
+// along with a Client configured for it.
+func testWebhookServer(status int, resp string, last **http.Request, body *string) (*httptest.Server, *Client) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		*last, *body = r, string(b)
+		w.WriteHeader(status)
+		io.WriteString(w, resp)
+	}))
+
+	c := NewClient(testClientID, testToken, ts.Client())
+	c.rootURL = ts.URL + "/"
+
+	return ts, c
+}
+
+func TestWebhookService_Register(t *testing.T) {
+	var r *http.Request
+	var body string
+	ts, c := testWebhookServer(http.StatusOK, "["+testWebhook+"]", &r, &body)
+	defer ts.Close()
+
+	wh, err := c.Webhooks.Register(EndpointGame, "https://example.com/igdb/games/update", "shh", WebhookUpdate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wh.ID != 42 || wh.Method() != WebhookUpdate {
+		t.Errorf("got: <%v, %v>, want: <%v, %v>", wh.ID, wh.Method(), 42, WebhookUpdate)
+	}
+
+	if r.Method != "POST" || r.URL.Path != "/games/webhooks/" {
+		t.Errorf("got: <%v %v>, want: <POST /games/webhooks/>", r.Method, r.URL.Path)
+	}
+
+	if r.Header.Get("client-id") != testClientID || r.Header.Get("Authorization") != "Bearer "+testToken {
+		t.Errorf("got: <%v>, want: <authorization headers>", r.Header)
+	}
+
+	form, _ := url.ParseQuery(body)
+	if form.Get("url") != "https://example.com/igdb/games/update" || form.Get("secret") != "shh" || form.Get("method") != "update" {
+		t.Errorf("got: <%v>, want: <url, secret, and method>", form)
+	}
+}
+
+func TestWebhookService_RegisterErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		end     endpoint
+		method  WebhookMethod
+		wantErr error
+	}{
+		{"Unknown endpoint", endpoint("foos/"), WebhookCreate, ErrUnknownEndpoint},
+		{"Unknown method", EndpointGame, WebhookMethod("upsert"), ErrUnknownWebhookMethod},
+		{"Empty secret", EndpointGame, WebhookCreate, ErrEmptySecret},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewClient(testClientID, testToken, nil)
+
+			secret := "shh"
+			if test.wantErr == ErrEmptySecret {
+				secret = ""
+			}
+
+			wh, err := c.Webhooks.Register(test.end, "https://example.com", secret, test.method)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if wh != nil {
+				t.Errorf("got: <%v>, want: <nil>", wh)
+			}
+		})
+	}
+}
+
+func TestWebhookService_GetListDelete(t *testing.T) {
+	var r *http.Request
+	var body string
+	ts, c := testWebhookServer(http.StatusOK, testWebhook, &r, &body)
+	defer ts.Close()
+
+	wh, err := c.Webhooks.Get(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wh.ID != 42 || r.Method != "GET" || r.URL.Path != "/webhooks/42" {
+		t.Errorf("got: <%v, %v %v>, want: <%v, GET /webhooks/42>", wh.ID, r.Method, r.URL.Path, 42)
+	}
+
+	list, err := c.Webhooks.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list) != 1 || r.URL.Path != "/webhooks/" {
+		t.Errorf("got: <%v, %v>, want: <1 webhook, /webhooks/>", len(list), r.URL.Path)
+	}
+
+	wh, err = c.Webhooks.Delete(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wh.ID != 42 || r.Method != "DELETE" || r.URL.Path != "/webhooks/42" {
+		t.Errorf("got: <%v, %v %v>, want: <%v, DELETE /webhooks/42>", wh.ID, r.Method, r.URL.Path, 42)
+	}
+}
+
+func TestWebhookService_Test(t *testing.T) {
+	var r *http.Request
+	var body string
+	ts, c := testWebhookServer(http.StatusOK, "", &r, &body)
+	defer ts.Close()
+
+	if err := c.Webhooks.Test(EndpointGame, 42, 1942); err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Method != "POST" || r.URL.Path != "/games/webhooks/test/42" || r.URL.Query().Get("entityId") != "1942" {
+		t.Errorf("got: <%v %v>, want: <POST /games/webhooks/test/42?entityId=1942>", r.Method, r.URL)
+	}
+}
+
+func TestWebhookServiceErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		status  int
+		resp    string
+		id      int
+		wantErr error
+	}{
+		{"Negative ID", http.StatusOK, "[]", -1, ErrNegativeID},
+		{"No results", http.StatusOK, "[]", 1, ErrNoResults},
+		{"Empty response", http.StatusOK, "", 1, errInvalidJSON},
+		{"Bad status", http.StatusBadRequest, "", 1, ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRepeat(test.status, test.resp)
+			defer ts.Close()
+
+			wh, err := c.Webhooks.Get(test.id)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if wh != nil {
+				t.Errorf("got: <%v>, want: <nil>", wh)
+			}
+
+			wh, err = c.Webhooks.Delete(test.id)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if wh != nil {
+				t.Errorf("got: <%v>, want: <nil>", wh)
+			}
+		})
+	}
+}
+
+func TestWebhook_Method(t *testing.T) {
+	var tests = []struct {
+		sub        int
+		wantMethod WebhookMethod
+	}{
+		{0, WebhookCreate},
+		{1, WebhookDelete},
+		{2, WebhookUpdate},
+		{3, ""},
+		{-1, ""},
+	}
+
+	for _, test := range tests {
+		if m := (&Webhook{SubCategory: test.sub}).Method(); m != test.wantMethod {
+			t.Errorf("got: <%v>, want: <%v>", m, test.wantMethod)
+		}
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	h, err := NewWebhookHandler("shh")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var updated *Game
+	if err := h.Handle(EndpointGame, WebhookUpdate, func(g *Game) { updated = g }); err != nil {
+		t.Fatal(err)
+	}
+
+	var deleted *Company
+	if err := h.Handle(EndpointCompany, WebhookDelete, func(c *Company) { deleted = c }); err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name       string
+		method     string
+		path       string
+		secret     string
+		body       string
+		wantStatus int
+	}{
+		{"Game update", "POST", "/igdb/games/update", "shh", `{"id": 1942, "name": "The Witcher 3"}`, http.StatusOK},
+		{"Company delete", "POST", "/igdb/companies/delete", "shh", `{"id": 70}`, http.StatusOK},
+		{"Wrong secret", "POST", "/igdb/games/update", "nope", `{"id": 1}`, http.StatusUnauthorized},
+		{"Wrong method", "GET", "/igdb/games/update", "shh", "", http.StatusMethodNotAllowed},
+		{"No callback", "POST", "/igdb/games/create", "shh", `{"id": 1}`, http.StatusNotFound},
+		{"Unknown endpoint", "POST", "/igdb/foos/update", "shh", `{"id": 1}`, http.StatusNotFound},
+		{"Short path", "POST", "/update", "shh", `{"id": 1}`, http.StatusNotFound},
+		{"Invalid body", "POST", "/igdb/games/update", "shh", `{"id": "one"`, http.StatusBadRequest},
+		{"Missing secret", "POST", "/igdb/games/update", "", `{"id": 1}`, http.StatusUnauthorized},
+		{"Oversized body", "POST", "/igdb/games/update", "shh", `{"id": 1, "summary": "` + strings.Repeat("a", maxWebhookBody) + `"}`, http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			req.Header.Set("X-Secret", test.secret)
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+			if rec.Code != test.wantStatus {
+				t.Errorf("got: <%v>, want: <%v>", rec.Code, test.wantStatus)
+			}
+		})
+	}
+
+	if updated == nil || updated.ID != 1942 || updated.Name != "The Witcher 3" {
+		t.Errorf("got: <%v>, want: <game 1942>", updated)
+	}
+
+	if deleted == nil || deleted.ID != 70 {
+		t.Errorf("got: <%v>, want: <company 70>", deleted)
+	}
+}
+
+func TestNewWebhookHandler(t *testing.T) {
+	for _, secret := range []string{"", "  "} {
+		h, err := NewWebhookHandler(secret)
+		if err != ErrEmptySecret {
+			t.Errorf("got: <%v>, want: <%v>", err, ErrEmptySecret)
+		}
+
+		if h != nil {
+			t.Errorf("got: <%v>, want: <nil>", h)
+		}
+	}
+}
+
+func TestWebhookHandler_HandleErrors(t *testing.T) {
+	var tests = []struct {
+		name     string
+		end      endpoint
+		method   WebhookMethod
+		callback interface{}
+		wantErr  error
+	}{
+		{"Unknown endpoint", endpoint("foos/"), WebhookCreate, func(g *Game) {}, ErrUnknownEndpoint},
+		{"Unknown method", EndpointGame, WebhookMethod("upsert"), func(g *Game) {}, ErrUnknownWebhookMethod},
+		{"Non-function callback", EndpointGame, WebhookCreate, "game", ErrInvalidCallback},
+		{"Nil callback", EndpointGame, WebhookCreate, (func(*Game))(nil), ErrInvalidCallback},
+		{"Mismatched type", EndpointGame, WebhookCreate, func(c *Company) {}, ErrInvalidCallback},
+		{"Non-pointer argument", EndpointGame, WebhookCreate, func(g Game) {}, ErrInvalidCallback},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h, err := NewWebhookHandler("shh")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = h.Handle(test.end, test.method, test.callback)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookURL(t *testing.T) {
+	var tests = []struct {
+		base    string
+		end     endpoint
+		method  WebhookMethod
+		wantURL string
+	}{
+		{"https://example.com/igdb", EndpointGame, WebhookUpdate, "https://example.com/igdb/games/update"},
+		{"https://example.com/igdb/", EndpointCompany, WebhookDelete, "https://example.com/igdb/companies/delete"},
+	}
+
+	for _, test := range tests {
+		if u := WebhookURL(test.base, test.end, test.method); u != test.wantURL {
+			t.Errorf("got: <%v>, want: <%v>", u, test.wantURL)
+		}
+
+		if route, ok := parseWebhookPath(test.wantURL); !ok || route.end != test.end || route.method != test.method {
+			t.Errorf("got: <%v>, want: <%v %v>", route, test.end, test.method)
+		}
+	}
+}
@@ -11,13 +11,26 @@ import (
 // Website represents a website and its URL; usually associated with a game.
 // For more information visit: https://api-docs.igdb.com/#website
 type Website struct {
+	Presence
+	Extras
 	ID       int             `json:"id"`
 	Category WebsiteCategory `json:"category"`
 	Trusted  bool            `json:"trusted"`
+	Type     int             `json:"type"`
 	URL      string          `json:"url"`
 }
 
+// UnmarshalJSON decodes the provided JSON object into the Website and
+// records which of its fields were present or unrecognized.
+func (w *Website) UnmarshalJSON(b []byte) error {
+	type website Website
+	return decodeModel(b, (*website)(w), &w.Presence, &w.Extras)
+}
+
 // WebsiteCategory specifies a specific popular website.
+//
+// The IGDB v4 deprecates the category field of a Website in favor
+// of the type field, which holds a Website Type ID instead.
 type WebsiteCategory int
 
 // Expected WebsiteCategory enums from the IGDB.
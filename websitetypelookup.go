@@ -0,0 +1,114 @@
+package igdb
+
+import (
+	"github.com/Henry-Sarabia/sliceconv"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+//go:generate gomodifytags -file $GOFILE -struct WebsiteTypeLookup -add-tags json -w
+
+// WebsiteTypeLookup represents a type of website such as an official site or a
+// storefront. It replaces the WebsiteCategory enums in the IGDB v4.
+// For more information visit: https://api-docs.igdb.com/#website-type
+type WebsiteTypeLookup struct {
+	Presence
+	Extras
+	ID        int       `json:"id"`
+	Checksum  string    `json:"checksum"`
+	CreatedAt Timestamp `json:"created_at"`
+	Type      string    `json:"type"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+// UnmarshalJSON decodes the provided JSON object into the WebsiteTypeLookup and
+// records which of its fields were present or unrecognized.
+func (w *WebsiteTypeLookup) UnmarshalJSON(b []byte) error {
+	type websiteTypeLookup WebsiteTypeLookup
+	return decodeModel(b, (*websiteTypeLookup)(w), &w.Presence, &w.Extras)
+}
+
+// WebsiteTypeLookupService handles all the API calls for the IGDB WebsiteTypeLookup endpoint.
+type WebsiteTypeLookupService service
+
+// Get returns a single WebsiteTypeLookup identified by the provided IGDB ID. Provide
+// the SetFields functional option if you need to specify which fields to
+// retrieve. If the ID does not match any WebsiteTypeLookups, an error is returned.
+func (ws *WebsiteTypeLookupService) Get(id int, opts ...Option) (*WebsiteTypeLookup, error) {
+	if id < 0 {
+		return nil, ErrNegativeID
+	}
+
+	var typ []*WebsiteTypeLookup
+
+	opts = append(opts, SetFilter("id", OpEquals, strconv.Itoa(id)))
+	err := ws.client.post(ws.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get WebsiteTypeLookup with ID %v", id)
+	}
+
+	return typ[0], nil
+}
+
+// List returns a list of WebsiteTypeLookups identified by the provided list of IGDB IDs.
+// Provide functional options to sort, filter, and paginate the results.
+// Any ID that does not match a WebsiteTypeLookup is ignored. If none of the IDs
+// match a WebsiteTypeLookup, an error is returned.
+func (ws *WebsiteTypeLookupService) List(ids []int, opts ...Option) ([]*WebsiteTypeLookup, error) {
+	for len(ids) < 1 {
+		return nil, ErrEmptyIDs
+	}
+
+	for _, id := range ids {
+		if id < 0 {
+			return nil, ErrNegativeID
+		}
+	}
+
+	var typ []*WebsiteTypeLookup
+
+	opts = append(opts, SetFilter("id", OpContainsAtLeast, sliceconv.Itoa(ids)...))
+	err := ws.client.post(ws.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get WebsiteTypeLookups with IDs %v", ids)
+	}
+
+	return typ, nil
+}
+
+// Index returns an index of WebsiteTypeLookups based solely on the provided functional
+// options used to sort, filter, and paginate the results. If no WebsiteTypeLookups can
+// be found using the provided options, an error is returned.
+func (ws *WebsiteTypeLookupService) Index(opts ...Option) ([]*WebsiteTypeLookup, error) {
+	var typ []*WebsiteTypeLookup
+
+	err := ws.client.post(ws.end, &typ, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get index of WebsiteTypeLookups")
+	}
+
+	return typ, nil
+}
+
+// Count returns the number of WebsiteTypeLookups available in the IGDB.
+// Provide the SetFilter functional option if you need to filter
+// which WebsiteTypeLookups to count.
+func (ws *WebsiteTypeLookupService) Count(opts ...Option) (int, error) {
+	ct, err := ws.client.getCount(ws.end, opts...)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot count WebsiteTypeLookups")
+	}
+
+	return ct, nil
+}
+
+// Fields returns the up-to-date list of fields in an
+// IGDB WebsiteTypeLookup object.
+func (ws *WebsiteTypeLookupService) Fields() ([]string, error) {
+	f, err := ws.client.getFields(ws.end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get WebsiteTypeLookup fields")
+	}
+
+	return f, nil
+}
@@ -0,0 +1,91 @@
+package igdb
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidURL occurs when a URL does not point to the IGDB web
+// page of an object that can be retrieved by its slug.
+var ErrInvalidURL = errors.New("URL is not a supported IGDB web page")
+
+// webPaths maps the first path segment of the IGDB web pages
+// to the endpoint of the objects those pages show.
+var webPaths = map[string]endpoint{
+	"characters":          EndpointCharacter,
+	"collections":         EndpointCollection,
+	"companies":           EndpointCompany,
+	"events":              EndpointEvent,
+	"franchises":          EndpointFranchise,
+	"game_engines":        EndpointGameEngine,
+	"game_modes":          EndpointGameMode,
+	"games":               EndpointGame,
+	"genres":              EndpointGenre,
+	"keywords":            EndpointKeyword,
+	"platform_families":   EndpointPlatformFamily,
+	"platforms":           EndpointPlatform,
+	"player_perspectives": EndpointPlayerPerspective,
+	"themes":              EndpointTheme,
+}
+
+// ParseURL returns the endpoint and slug of the object shown by the IGDB
+// web page at the provided URL. For example, the URL
+// "https://www.igdb.com/games/the-witcher-3-wild-hunt" returns EndpointGame
+// and "the-witcher-3-wild-hunt". The scheme and "www" may be omitted, and any
+// path after the slug, query, or fragment is ignored. If the URL is not the
+// web page of an object with a slug, an error is returned.
+func ParseURL(rawURL string) (endpoint, string, error) {
+	raw := strings.TrimSpace(rawURL)
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", "", errors.Wrapf(ErrInvalidURL, "cannot parse URL '%s'", rawURL)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host != "igdb.com" && !strings.HasSuffix(host, ".igdb.com") {
+		return "", "", errors.Wrapf(ErrInvalidURL, "cannot parse URL '%s' outside of igdb.com", rawURL)
+	}
+
+	segs := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segs) < 2 || segs[1] == "" {
+		return "", "", errors.Wrapf(ErrInvalidURL, "cannot parse URL '%s' without a slug", rawURL)
+	}
+
+	end, ok := webPaths[strings.Replace(strings.ToLower(segs[0]), "-", "_", -1)]
+	if !ok {
+		return "", "", errors.Wrapf(ErrInvalidURL, "cannot parse URL '%s' of unsupported page", rawURL)
+	}
+
+	return end, segs[1], nil
+}
+
+// GetByURL returns the object shown by the IGDB web page at the provided URL,
+// as parsed by the ParseURL function, along with its endpoint. The object is
+// a pointer to the struct type of the endpoint, such as a *Game for a URL of
+// the form "https://www.igdb.com/games/{slug}". Provide the SetFields
+// functional option if you need to specify which fields to retrieve. If the
+// URL is not supported or the slug does not match any object, an error is
+// returned.
+func (c *Client) GetByURL(rawURL string, opts ...Option) (interface{}, endpoint, error) {
+	end, slug, err := ParseURL(rawURL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := reflect.New(reflect.SliceOf(reflect.PtrTo(modelTypes[end])))
+
+	opts = append(opts, setSlug(slug))
+	err = c.post(end, res.Interface(), opts...)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "cannot get object with slug %s from '%s' endpoint", slug, end)
+	}
+
+	return res.Elem().Index(0).Interface(), end, nil
+}
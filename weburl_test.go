@@ -0,0 +1,103 @@
+package igdb
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseURL(t *testing.T) {
+	var tests = []struct {
+		name     string
+		url      string
+		wantEnd  endpoint
+		wantSlug string
+		wantErr  error
+	}{
+		{"Game URL", "https://www.igdb.com/games/the-witcher-3-wild-hunt", EndpointGame, "the-witcher-3-wild-hunt", nil},
+		{"No scheme", "igdb.com/companies/cd-projekt-red", EndpointCompany, "cd-projekt-red", nil},
+		{"Hyphenated path", "https://www.igdb.com/player-perspectives/first-person", EndpointPlayerPerspective, "first-person", nil},
+		{"Underscored path", "https://www.igdb.com/game_engines/redengine", EndpointGameEngine, "redengine", nil},
+		{"Trailing path and query", "https://www.igdb.com/games/portal/reviews?page=2#top", EndpointGame, "portal", nil},
+		{"Uppercase host", "HTTPS://WWW.IGDB.COM/games/portal", EndpointGame, "portal", nil},
+		{"Other host", "https://example.com/games/portal", "", "", ErrInvalidURL},
+		{"Lookalike host", "https://notigdb.com/games/portal", "", "", ErrInvalidURL},
+		{"Missing slug", "https://www.igdb.com/games/", "", "", ErrInvalidURL},
+		{"Unsupported page", "https://www.igdb.com/lists/favorites", "", "", ErrInvalidURL},
+		{"Empty URL", "", "", "", ErrInvalidURL},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			end, slug, err := ParseURL(test.url)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if end != test.wantEnd {
+				t.Errorf("got: <%v>, want: <%v>", end, test.wantEnd)
+			}
+
+			if slug != test.wantSlug {
+				t.Errorf("got: <%v>, want: <%v>", slug, test.wantSlug)
+			}
+		})
+	}
+}
+
+func TestClient_GetByURL(t *testing.T) {
+	ts, c := testServerRoutes(map[string]string{
+		"/games/":     `[{"id": 1942, "name": "The Witcher 3: Wild Hunt", "slug": "the-witcher-3-wild-hunt"}]`,
+		"/companies/": `[{"id": 908, "name": "CD Projekt RED", "slug": "cd-projekt-red"}]`,
+	})
+	defer ts.Close()
+
+	obj, end, err := c.GetByURL("https://www.igdb.com/games/the-witcher-3-wild-hunt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g, ok := obj.(*Game)
+	if !ok || end != EndpointGame || g.ID != 1942 {
+		t.Errorf("got: <%T, %v>, want: <*Game with ID 1942>", obj, end)
+	}
+
+	obj, end, err = c.GetByURL("igdb.com/companies/cd-projekt-red", SetFields("name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	co, ok := obj.(*Company)
+	if !ok || end != EndpointCompany || co.Name != "CD Projekt RED" {
+		t.Errorf("got: <%T, %v>, want: <*Company named CD Projekt RED>", obj, end)
+	}
+}
+
+func TestClient_GetByURLErrors(t *testing.T) {
+	var tests = []struct {
+		name    string
+		url     string
+		opts    []Option
+		wantErr error
+	}{
+		{"Unsupported URL", "https://example.com/games/portal", nil, ErrInvalidURL},
+		{"No results", "https://www.igdb.com/games/non-existent-slug", nil, ErrNoResults},
+		{"Invalid option", "https://www.igdb.com/games/portal", []Option{SetOffset(-99999)}, ErrOutOfRange},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, c := testServerRoutes(map[string]string{})
+			defer ts.Close()
+
+			obj, _, err := c.GetByURL(test.url, test.opts...)
+			if errors.Cause(err) != test.wantErr {
+				t.Errorf("got: <%v>, want: <%v>", errors.Cause(err), test.wantErr)
+			}
+
+			if obj != nil {
+				t.Errorf("got: <%v>, want: <nil>", obj)
+			}
+		})
+	}
+}